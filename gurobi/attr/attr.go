@@ -0,0 +1,65 @@
+/*
+Package attr
+Description:
+
+	A catalog of typed descriptors for Gurobi's model, variable, linear constraint, quadratic
+	constraint, general constraint and SOS attributes, for use with the generic accessors of
+	the gurobi package:
+
+		x, err := gurobi.GetVarAttr(v, attr.X)
+		err = gurobi.SetVarAttr(v, attr.Obj, 2.0)
+		numVars, err := gurobi.GetModelAttr(model, attr.NumVars)
+
+	Read-only attributes (such as X or NumVars) have ReadOnly descriptor types, so passing them
+	to one of the Set*Attr functions does not compile. The descriptors and the Catalog table are
+	generated from attributes.csv by running go generate in this directory.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/attributes.html
+*/
+package attr
+
+//go:generate go run ./gen -in attributes.csv -out attributes.go
+
+// Kinds of objects that attributes belong to
+const (
+	ObjectModel     = "model"
+	ObjectVar       = "var"
+	ObjectConstr    = "constr"
+	ObjectQConstr   = "qconstr"
+	ObjectGenConstr = "genconstr"
+	ObjectSOS       = "sos"
+)
+
+// Types of attribute values
+const (
+	TypeInt    = "int"
+	TypeChar   = "char"
+	TypeDouble = "double"
+	TypeString = "string"
+)
+
+// Info describes one attribute in the Catalog.
+type Info struct {
+	Name        string
+	Object      string // One of ObjectModel, ObjectVar, ObjectConstr, ObjectQConstr, ObjectGenConstr or ObjectSOS
+	Type        string // One of TypeInt, TypeChar, TypeDouble or TypeString
+	Settable    bool
+	Description string
+}
+
+/*
+Lookup
+Description:
+
+	Returns the Catalog entry of the attribute with the given name.
+*/
+func Lookup(name string) (Info, bool) {
+	for _, info := range Catalog {
+		if info.Name == name {
+			return info, true
+		}
+	}
+	return Info{}, false
+}
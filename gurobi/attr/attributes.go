@@ -0,0 +1,570 @@
+// Code generated by "go run ./gen"; DO NOT EDIT.
+
+package attr
+
+import "github.com/MatProGo-dev/Gurobi.go/gurobi"
+
+// Model attributes
+var (
+	// NumConstrs: Number of linear constraints (read-only).
+	NumConstrs = gurobi.ReadOnlyModelIntAttr{Name: "NumConstrs"}
+	// NumVars: Number of variables (read-only).
+	NumVars = gurobi.ReadOnlyModelIntAttr{Name: "NumVars"}
+	// NumSOS: Number of SOS constraints (read-only).
+	NumSOS = gurobi.ReadOnlyModelIntAttr{Name: "NumSOS"}
+	// NumQConstrs: Number of quadratic constraints (read-only).
+	NumQConstrs = gurobi.ReadOnlyModelIntAttr{Name: "NumQConstrs"}
+	// NumGenConstrs: Number of general constraints (read-only).
+	NumGenConstrs = gurobi.ReadOnlyModelIntAttr{Name: "NumGenConstrs"}
+	// NumNZs: Number of nonzero coefficients in the linear constraints (read-only).
+	NumNZs = gurobi.ReadOnlyModelIntAttr{Name: "NumNZs"}
+	// DNumNZs: Number of nonzero coefficients in the linear constraints (as a double) (read-only).
+	DNumNZs = gurobi.ReadOnlyModelDoubleAttr{Name: "DNumNZs"}
+	// NumQNZs: Number of nonzero quadratic objective terms (read-only).
+	NumQNZs = gurobi.ReadOnlyModelIntAttr{Name: "NumQNZs"}
+	// NumQCNZs: Number of nonzero terms in the quadratic constraints (read-only).
+	NumQCNZs = gurobi.ReadOnlyModelIntAttr{Name: "NumQCNZs"}
+	// NumIntVars: Number of integer variables (read-only).
+	NumIntVars = gurobi.ReadOnlyModelIntAttr{Name: "NumIntVars"}
+	// NumBinVars: Number of binary variables (read-only).
+	NumBinVars = gurobi.ReadOnlyModelIntAttr{Name: "NumBinVars"}
+	// NumPWLObjVars: Number of variables with piecewise-linear objective functions (read-only).
+	NumPWLObjVars = gurobi.ReadOnlyModelIntAttr{Name: "NumPWLObjVars"}
+	// ModelName: Model name.
+	ModelName = gurobi.ModelStringAttr{Name: "ModelName"}
+	// ModelSense: Model sense (minimization or maximization).
+	ModelSense = gurobi.ModelIntAttr{Name: "ModelSense"}
+	// ObjCon: Constant offset of the objective function.
+	ObjCon = gurobi.ModelDoubleAttr{Name: "ObjCon"}
+	// Fingerprint: Fingerprint of the model data and parameters (read-only).
+	Fingerprint = gurobi.ReadOnlyModelIntAttr{Name: "Fingerprint"}
+	// ObjVal: Objective value of the current solution (read-only).
+	ObjVal = gurobi.ReadOnlyModelDoubleAttr{Name: "ObjVal"}
+	// ObjBound: Best known bound on the optimal objective (read-only).
+	ObjBound = gurobi.ReadOnlyModelDoubleAttr{Name: "ObjBound"}
+	// ObjBoundC: Best known bound on the optimal objective (not rounded) (read-only).
+	ObjBoundC = gurobi.ReadOnlyModelDoubleAttr{Name: "ObjBoundC"}
+	// PoolObjBound: Bound on the objective of undiscovered MIP solutions (read-only).
+	PoolObjBound = gurobi.ReadOnlyModelDoubleAttr{Name: "PoolObjBound"}
+	// PoolObjVal: Objective value of the solution selected by SolutionNumber (read-only).
+	PoolObjVal = gurobi.ReadOnlyModelDoubleAttr{Name: "PoolObjVal"}
+	// MIPGap: Current relative MIP optimality gap (read-only).
+	MIPGap = gurobi.ReadOnlyModelDoubleAttr{Name: "MIPGap"}
+	// Runtime: Runtime of the most recent optimization in seconds (read-only).
+	Runtime = gurobi.ReadOnlyModelDoubleAttr{Name: "Runtime"}
+	// Work: Work spent on the most recent optimization in work units (read-only).
+	Work = gurobi.ReadOnlyModelDoubleAttr{Name: "Work"}
+	// Status: Current optimization status (read-only).
+	Status = gurobi.ReadOnlyModelIntAttr{Name: "Status"}
+	// SolCount: Number of stored solutions (read-only).
+	SolCount = gurobi.ReadOnlyModelIntAttr{Name: "SolCount"}
+	// IterCount: Number of simplex iterations performed (read-only).
+	IterCount = gurobi.ReadOnlyModelDoubleAttr{Name: "IterCount"}
+	// BarIterCount: Number of barrier iterations performed (read-only).
+	BarIterCount = gurobi.ReadOnlyModelIntAttr{Name: "BarIterCount"}
+	// NodeCount: Number of branch-and-cut nodes explored (read-only).
+	NodeCount = gurobi.ReadOnlyModelDoubleAttr{Name: "NodeCount"}
+	// OpenNodeCount: Number of unexplored branch-and-cut nodes (read-only).
+	OpenNodeCount = gurobi.ReadOnlyModelDoubleAttr{Name: "OpenNodeCount"}
+	// IsMIP: Whether the model has discrete elements (read-only).
+	IsMIP = gurobi.ReadOnlyModelIntAttr{Name: "IsMIP"}
+	// IsQP: Whether the model has a quadratic objective (read-only).
+	IsQP = gurobi.ReadOnlyModelIntAttr{Name: "IsQP"}
+	// IsQCP: Whether the model has quadratic constraints (read-only).
+	IsQCP = gurobi.ReadOnlyModelIntAttr{Name: "IsQCP"}
+	// IsMultiObj: Whether the model has multiple objectives (read-only).
+	IsMultiObj = gurobi.ReadOnlyModelIntAttr{Name: "IsMultiObj"}
+	// IISMinimal: Whether the computed IIS is minimal (read-only).
+	IISMinimal = gurobi.ReadOnlyModelIntAttr{Name: "IISMinimal"}
+	// MaxCoeff: Largest absolute value of a constraint coefficient (read-only).
+	MaxCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MaxCoeff"}
+	// MinCoeff: Smallest absolute value of a nonzero constraint coefficient (read-only).
+	MinCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MinCoeff"}
+	// MaxBound: Largest finite absolute variable bound (read-only).
+	MaxBound = gurobi.ReadOnlyModelDoubleAttr{Name: "MaxBound"}
+	// MinBound: Smallest nonzero absolute variable bound (read-only).
+	MinBound = gurobi.ReadOnlyModelDoubleAttr{Name: "MinBound"}
+	// MaxObjCoeff: Largest absolute value of a linear objective coefficient (read-only).
+	MaxObjCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MaxObjCoeff"}
+	// MinObjCoeff: Smallest absolute value of a nonzero linear objective coefficient (read-only).
+	MinObjCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MinObjCoeff"}
+	// MaxRHS: Largest absolute value of a linear constraint right-hand side (read-only).
+	MaxRHS = gurobi.ReadOnlyModelDoubleAttr{Name: "MaxRHS"}
+	// MinRHS: Smallest absolute value of a nonzero linear constraint right-hand side (read-only).
+	MinRHS = gurobi.ReadOnlyModelDoubleAttr{Name: "MinRHS"}
+	// MaxQCCoeff: Largest absolute value of a quadratic constraint coefficient (read-only).
+	MaxQCCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MaxQCCoeff"}
+	// MinQCCoeff: Smallest absolute value of a nonzero quadratic constraint coefficient (read-only).
+	MinQCCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MinQCCoeff"}
+	// MaxQCLCoeff: Largest absolute value of a linear coefficient in a quadratic constraint (read-only).
+	MaxQCLCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MaxQCLCoeff"}
+	// MinQCLCoeff: Smallest absolute value of a nonzero linear coefficient in a quadratic constraint (read-only).
+	MinQCLCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MinQCLCoeff"}
+	// MaxQCRHS: Largest absolute value of a quadratic constraint right-hand side (read-only).
+	MaxQCRHS = gurobi.ReadOnlyModelDoubleAttr{Name: "MaxQCRHS"}
+	// MinQCRHS: Smallest absolute value of a nonzero quadratic constraint right-hand side (read-only).
+	MinQCRHS = gurobi.ReadOnlyModelDoubleAttr{Name: "MinQCRHS"}
+	// MaxQObjCoeff: Largest absolute value of a quadratic objective coefficient (read-only).
+	MaxQObjCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MaxQObjCoeff"}
+	// MinQObjCoeff: Smallest absolute value of a nonzero quadratic objective coefficient (read-only).
+	MinQObjCoeff = gurobi.ReadOnlyModelDoubleAttr{Name: "MinQObjCoeff"}
+	// Kappa: Estimated condition number of the optimal basis matrix (read-only).
+	Kappa = gurobi.ReadOnlyModelDoubleAttr{Name: "Kappa"}
+	// KappaExact: Exact condition number of the optimal basis matrix (read-only).
+	KappaExact = gurobi.ReadOnlyModelDoubleAttr{Name: "KappaExact"}
+	// FarkasProof: Magnitude of the infeasibility proven by the Farkas certificate (read-only).
+	FarkasProof = gurobi.ReadOnlyModelDoubleAttr{Name: "FarkasProof"}
+	// NumStart: Number of MIP starts.
+	NumStart = gurobi.ModelIntAttr{Name: "NumStart"}
+	// NumObj: Number of objectives.
+	NumObj = gurobi.ModelIntAttr{Name: "NumObj"}
+	// NumScenarios: Number of scenarios.
+	NumScenarios = gurobi.ModelIntAttr{Name: "NumScenarios"}
+	// ConcurrentWinMethod: Method that won the most recent concurrent optimization (read-only).
+	ConcurrentWinMethod = gurobi.ReadOnlyModelIntAttr{Name: "ConcurrentWinMethod"}
+	// LicenseExpiration: License expiration date (read-only).
+	LicenseExpiration = gurobi.ReadOnlyModelIntAttr{Name: "LicenseExpiration"}
+	// MemUsed: Memory currently used (in GB) (read-only).
+	MemUsed = gurobi.ReadOnlyModelDoubleAttr{Name: "MemUsed"}
+	// MaxMemUsed: Maximum memory used so far (in GB) (read-only).
+	MaxMemUsed = gurobi.ReadOnlyModelDoubleAttr{Name: "MaxMemUsed"}
+	// TuneResultCount: Number of parameter sets found by the tuning tool (read-only).
+	TuneResultCount = gurobi.ReadOnlyModelIntAttr{Name: "TuneResultCount"}
+	// JobID: Job ID of a Compute Server or Instant Cloud job (read-only).
+	JobID = gurobi.ReadOnlyModelStringAttr{Name: "JobID"}
+	// ObjNCon: Constant offset of the objective selected by ObjNumber.
+	ObjNCon = gurobi.ModelDoubleAttr{Name: "ObjNCon"}
+	// ObjNPriority: Priority of the objective selected by ObjNumber.
+	ObjNPriority = gurobi.ModelIntAttr{Name: "ObjNPriority"}
+	// ObjNWeight: Weight of the objective selected by ObjNumber.
+	ObjNWeight = gurobi.ModelDoubleAttr{Name: "ObjNWeight"}
+	// ObjNRelTol: Relative degradation allowed for the objective selected by ObjNumber.
+	ObjNRelTol = gurobi.ModelDoubleAttr{Name: "ObjNRelTol"}
+	// ObjNAbsTol: Absolute degradation allowed for the objective selected by ObjNumber.
+	ObjNAbsTol = gurobi.ModelDoubleAttr{Name: "ObjNAbsTol"}
+	// ObjNVal: Value of the objective selected by ObjNumber in the solution selected by SolutionNumber (read-only).
+	ObjNVal = gurobi.ReadOnlyModelDoubleAttr{Name: "ObjNVal"}
+	// ObjNName: Name of the objective selected by ObjNumber.
+	ObjNName = gurobi.ModelStringAttr{Name: "ObjNName"}
+	// ScenNName: Name of the scenario selected by ScenarioNumber.
+	ScenNName = gurobi.ModelStringAttr{Name: "ScenNName"}
+	// ScenNObjBound: Objective bound of the scenario selected by ScenarioNumber (read-only).
+	ScenNObjBound = gurobi.ReadOnlyModelDoubleAttr{Name: "ScenNObjBound"}
+	// ScenNObjVal: Objective value of the scenario selected by ScenarioNumber (read-only).
+	ScenNObjVal = gurobi.ReadOnlyModelDoubleAttr{Name: "ScenNObjVal"}
+	// BoundVio: Maximum (unscaled) bound violation (read-only).
+	BoundVio = gurobi.ReadOnlyModelDoubleAttr{Name: "BoundVio"}
+	// BoundSVio: Maximum (scaled) bound violation (read-only).
+	BoundSVio = gurobi.ReadOnlyModelDoubleAttr{Name: "BoundSVio"}
+	// BoundVioIndex: Index of the variable with the largest (unscaled) bound violation (read-only).
+	BoundVioIndex = gurobi.ReadOnlyModelIntAttr{Name: "BoundVioIndex"}
+	// BoundSVioIndex: Index of the variable with the largest (scaled) bound violation (read-only).
+	BoundSVioIndex = gurobi.ReadOnlyModelIntAttr{Name: "BoundSVioIndex"}
+	// BoundVioSum: Sum of (unscaled) bound violations (read-only).
+	BoundVioSum = gurobi.ReadOnlyModelDoubleAttr{Name: "BoundVioSum"}
+	// BoundSVioSum: Sum of (scaled) bound violations (read-only).
+	BoundSVioSum = gurobi.ReadOnlyModelDoubleAttr{Name: "BoundSVioSum"}
+	// ConstrVio: Maximum (unscaled) constraint violation (read-only).
+	ConstrVio = gurobi.ReadOnlyModelDoubleAttr{Name: "ConstrVio"}
+	// ConstrSVio: Maximum (scaled) constraint violation (read-only).
+	ConstrSVio = gurobi.ReadOnlyModelDoubleAttr{Name: "ConstrSVio"}
+	// ConstrVioIndex: Index of the constraint with the largest (unscaled) violation (read-only).
+	ConstrVioIndex = gurobi.ReadOnlyModelIntAttr{Name: "ConstrVioIndex"}
+	// ConstrSVioIndex: Index of the constraint with the largest (scaled) violation (read-only).
+	ConstrSVioIndex = gurobi.ReadOnlyModelIntAttr{Name: "ConstrSVioIndex"}
+	// ConstrVioSum: Sum of (unscaled) constraint violations (read-only).
+	ConstrVioSum = gurobi.ReadOnlyModelDoubleAttr{Name: "ConstrVioSum"}
+	// ConstrSVioSum: Sum of (scaled) constraint violations (read-only).
+	ConstrSVioSum = gurobi.ReadOnlyModelDoubleAttr{Name: "ConstrSVioSum"}
+	// ConstrResidual: Maximum (unscaled) primal constraint residual (read-only).
+	ConstrResidual = gurobi.ReadOnlyModelDoubleAttr{Name: "ConstrResidual"}
+	// ConstrSResidual: Maximum (scaled) primal constraint residual (read-only).
+	ConstrSResidual = gurobi.ReadOnlyModelDoubleAttr{Name: "ConstrSResidual"}
+	// ConstrResidualIndex: Index of the constraint with the largest (unscaled) primal residual (read-only).
+	ConstrResidualIndex = gurobi.ReadOnlyModelIntAttr{Name: "ConstrResidualIndex"}
+	// ConstrSResidualIndex: Index of the constraint with the largest (scaled) primal residual (read-only).
+	ConstrSResidualIndex = gurobi.ReadOnlyModelIntAttr{Name: "ConstrSResidualIndex"}
+	// ConstrResidualSum: Sum of (unscaled) primal constraint residuals (read-only).
+	ConstrResidualSum = gurobi.ReadOnlyModelDoubleAttr{Name: "ConstrResidualSum"}
+	// ConstrSResidualSum: Sum of (scaled) primal constraint residuals (read-only).
+	ConstrSResidualSum = gurobi.ReadOnlyModelDoubleAttr{Name: "ConstrSResidualSum"}
+	// DualVio: Maximum (unscaled) reduced cost violation (read-only).
+	DualVio = gurobi.ReadOnlyModelDoubleAttr{Name: "DualVio"}
+	// DualSVio: Maximum (scaled) reduced cost violation (read-only).
+	DualSVio = gurobi.ReadOnlyModelDoubleAttr{Name: "DualSVio"}
+	// DualVioIndex: Index of the variable with the largest (unscaled) reduced cost violation (read-only).
+	DualVioIndex = gurobi.ReadOnlyModelIntAttr{Name: "DualVioIndex"}
+	// DualSVioIndex: Index of the variable with the largest (scaled) reduced cost violation (read-only).
+	DualSVioIndex = gurobi.ReadOnlyModelIntAttr{Name: "DualSVioIndex"}
+	// DualVioSum: Sum of (unscaled) reduced cost violations (read-only).
+	DualVioSum = gurobi.ReadOnlyModelDoubleAttr{Name: "DualVioSum"}
+	// DualSVioSum: Sum of (scaled) reduced cost violations (read-only).
+	DualSVioSum = gurobi.ReadOnlyModelDoubleAttr{Name: "DualSVioSum"}
+	// DualResidual: Maximum (unscaled) dual constraint residual (read-only).
+	DualResidual = gurobi.ReadOnlyModelDoubleAttr{Name: "DualResidual"}
+	// DualSResidual: Maximum (scaled) dual constraint residual (read-only).
+	DualSResidual = gurobi.ReadOnlyModelDoubleAttr{Name: "DualSResidual"}
+	// DualResidualIndex: Index of the variable with the largest (unscaled) dual residual (read-only).
+	DualResidualIndex = gurobi.ReadOnlyModelIntAttr{Name: "DualResidualIndex"}
+	// DualSResidualIndex: Index of the variable with the largest (scaled) dual residual (read-only).
+	DualSResidualIndex = gurobi.ReadOnlyModelIntAttr{Name: "DualSResidualIndex"}
+	// DualResidualSum: Sum of (unscaled) dual constraint residuals (read-only).
+	DualResidualSum = gurobi.ReadOnlyModelDoubleAttr{Name: "DualResidualSum"}
+	// DualSResidualSum: Sum of (scaled) dual constraint residuals (read-only).
+	DualSResidualSum = gurobi.ReadOnlyModelDoubleAttr{Name: "DualSResidualSum"}
+	// ComplVio: Maximum complementarity violation (read-only).
+	ComplVio = gurobi.ReadOnlyModelDoubleAttr{Name: "ComplVio"}
+	// ComplVioIndex: Index of the variable with the largest complementarity violation (read-only).
+	ComplVioIndex = gurobi.ReadOnlyModelIntAttr{Name: "ComplVioIndex"}
+	// ComplVioSum: Sum of complementarity violations (read-only).
+	ComplVioSum = gurobi.ReadOnlyModelDoubleAttr{Name: "ComplVioSum"}
+	// IntVio: Maximum integrality violation (read-only).
+	IntVio = gurobi.ReadOnlyModelDoubleAttr{Name: "IntVio"}
+	// IntVioIndex: Index of the variable with the largest integrality violation (read-only).
+	IntVioIndex = gurobi.ReadOnlyModelIntAttr{Name: "IntVioIndex"}
+	// IntVioSum: Sum of integrality violations (read-only).
+	IntVioSum = gurobi.ReadOnlyModelDoubleAttr{Name: "IntVioSum"}
+)
+
+// Variable attributes
+var (
+	// LB: Lower bound.
+	LB = gurobi.VarDoubleAttr{Name: "LB"}
+	// UB: Upper bound.
+	UB = gurobi.VarDoubleAttr{Name: "UB"}
+	// Obj: Linear objective coefficient.
+	Obj = gurobi.VarDoubleAttr{Name: "Obj"}
+	// VType: Variable type (continuous/binary/integer/semi-continuous/semi-integer).
+	VType = gurobi.VarCharAttr{Name: "VType"}
+	// VarName: Variable name.
+	VarName = gurobi.VarStringAttr{Name: "VarName"}
+	// VTag: Tag used to identify the variable in JSON solution files.
+	VTag = gurobi.VarStringAttr{Name: "VTag"}
+	// X: Value in the current solution (read-only).
+	X = gurobi.ReadOnlyVarDoubleAttr{Name: "X"}
+	// Xn: Value in the solution selected by SolutionNumber (read-only).
+	Xn = gurobi.ReadOnlyVarDoubleAttr{Name: "Xn"}
+	// RC: Reduced cost (read-only).
+	RC = gurobi.ReadOnlyVarDoubleAttr{Name: "RC"}
+	// BarX: Value in the best barrier iterate (before crossover) (read-only).
+	BarX = gurobi.ReadOnlyVarDoubleAttr{Name: "BarX"}
+	// Start: Value in the current MIP start.
+	Start = gurobi.VarDoubleAttr{Name: "Start"}
+	// VarHintVal: Hint for the value of the variable in a MIP solution.
+	VarHintVal = gurobi.VarDoubleAttr{Name: "VarHintVal"}
+	// VarHintPri: Priority of the variable hint.
+	VarHintPri = gurobi.VarIntAttr{Name: "VarHintPri"}
+	// BranchPriority: Branching priority.
+	BranchPriority = gurobi.VarIntAttr{Name: "BranchPriority"}
+	// Partition: Partition used by the partition heuristic.
+	Partition = gurobi.VarIntAttr{Name: "Partition"}
+	// VBasis: Status of the variable in the current basis.
+	VBasis = gurobi.VarIntAttr{Name: "VBasis"}
+	// PStart: Value in the primal simplex start vector.
+	PStart = gurobi.VarDoubleAttr{Name: "PStart"}
+	// PreFixVal: Value used to fix the variable in presolve.
+	PreFixVal = gurobi.VarDoubleAttr{Name: "PreFixVal"}
+	// PWLObjCvx: Whether the piecewise-linear objective function is convex (read-only).
+	PWLObjCvx = gurobi.ReadOnlyVarIntAttr{Name: "PWLObjCvx"}
+	// UnbdRay: Value in the unbounded ray (read-only).
+	UnbdRay = gurobi.ReadOnlyVarDoubleAttr{Name: "UnbdRay"}
+	// SAObjLow: Smallest objective coefficient for which the basis stays optimal (read-only).
+	SAObjLow = gurobi.ReadOnlyVarDoubleAttr{Name: "SAObjLow"}
+	// SAObjUp: Largest objective coefficient for which the basis stays optimal (read-only).
+	SAObjUp = gurobi.ReadOnlyVarDoubleAttr{Name: "SAObjUp"}
+	// SALBLow: Smallest lower bound for which the basis stays optimal (read-only).
+	SALBLow = gurobi.ReadOnlyVarDoubleAttr{Name: "SALBLow"}
+	// SALBUp: Largest lower bound for which the basis stays optimal (read-only).
+	SALBUp = gurobi.ReadOnlyVarDoubleAttr{Name: "SALBUp"}
+	// SAUBLow: Smallest upper bound for which the basis stays optimal (read-only).
+	SAUBLow = gurobi.ReadOnlyVarDoubleAttr{Name: "SAUBLow"}
+	// SAUBUp: Largest upper bound for which the basis stays optimal (read-only).
+	SAUBUp = gurobi.ReadOnlyVarDoubleAttr{Name: "SAUBUp"}
+	// IISLB: Whether the lower bound participates in the IIS (read-only).
+	IISLB = gurobi.ReadOnlyVarIntAttr{Name: "IISLB"}
+	// IISUB: Whether the upper bound participates in the IIS (read-only).
+	IISUB = gurobi.ReadOnlyVarIntAttr{Name: "IISUB"}
+	// IISLBForce: Forces the lower bound to be included in or excluded from the IIS.
+	IISLBForce = gurobi.VarIntAttr{Name: "IISLBForce"}
+	// IISUBForce: Forces the upper bound to be included in or excluded from the IIS.
+	IISUBForce = gurobi.VarIntAttr{Name: "IISUBForce"}
+	// ObjN: Coefficient in the objective selected by ObjNumber.
+	ObjN = gurobi.VarDoubleAttr{Name: "ObjN"}
+	// ScenNLB: Lower bound in the scenario selected by ScenarioNumber.
+	ScenNLB = gurobi.VarDoubleAttr{Name: "ScenNLB"}
+	// ScenNUB: Upper bound in the scenario selected by ScenarioNumber.
+	ScenNUB = gurobi.VarDoubleAttr{Name: "ScenNUB"}
+	// ScenNObj: Objective coefficient in the scenario selected by ScenarioNumber.
+	ScenNObj = gurobi.VarDoubleAttr{Name: "ScenNObj"}
+	// ScenNX: Value in the solution of the scenario selected by ScenarioNumber (read-only).
+	ScenNX = gurobi.ReadOnlyVarDoubleAttr{Name: "ScenNX"}
+	// PoolIgnore: Whether the variable is ignored when deciding if two pool solutions differ.
+	PoolIgnore = gurobi.VarIntAttr{Name: "PoolIgnore"}
+)
+
+// Linear constraint attributes
+var (
+	// Sense: Constraint sense.
+	Sense = gurobi.ConstrCharAttr{Name: "Sense"}
+	// RHS: Right-hand side.
+	RHS = gurobi.ConstrDoubleAttr{Name: "RHS"}
+	// ConstrName: Constraint name.
+	ConstrName = gurobi.ConstrStringAttr{Name: "ConstrName"}
+	// CTag: Tag used to identify the constraint in JSON solution files.
+	CTag = gurobi.ConstrStringAttr{Name: "CTag"}
+	// Lazy: Whether the constraint is treated as a lazy constraint or a user cut.
+	Lazy = gurobi.ConstrIntAttr{Name: "Lazy"}
+	// Pi: Dual value (shadow price) (read-only).
+	Pi = gurobi.ReadOnlyConstrDoubleAttr{Name: "Pi"}
+	// Slack: Slack in the current solution (read-only).
+	Slack = gurobi.ReadOnlyConstrDoubleAttr{Name: "Slack"}
+	// CBasis: Status of the constraint in the current basis.
+	CBasis = gurobi.ConstrIntAttr{Name: "CBasis"}
+	// DStart: Value in the dual simplex start vector.
+	DStart = gurobi.ConstrDoubleAttr{Name: "DStart"}
+	// FarkasDual: Farkas infeasibility certificate (read-only).
+	FarkasDual = gurobi.ReadOnlyConstrDoubleAttr{Name: "FarkasDual"}
+	// SARHSLow: Smallest right-hand side for which the basis stays optimal (read-only).
+	SARHSLow = gurobi.ReadOnlyConstrDoubleAttr{Name: "SARHSLow"}
+	// SARHSUp: Largest right-hand side for which the basis stays optimal (read-only).
+	SARHSUp = gurobi.ReadOnlyConstrDoubleAttr{Name: "SARHSUp"}
+	// IISConstr: Whether the constraint participates in the IIS (read-only).
+	IISConstr = gurobi.ReadOnlyConstrIntAttr{Name: "IISConstr"}
+	// IISConstrForce: Forces the constraint to be included in or excluded from the IIS.
+	IISConstrForce = gurobi.ConstrIntAttr{Name: "IISConstrForce"}
+	// ScenNRHS: Right-hand side in the scenario selected by ScenarioNumber.
+	ScenNRHS = gurobi.ConstrDoubleAttr{Name: "ScenNRHS"}
+)
+
+// Quadratic constraint attributes
+var (
+	// QCSense: Quadratic constraint sense.
+	QCSense = gurobi.QConstrCharAttr{Name: "QCSense"}
+	// QCRHS: Right-hand side of the quadratic constraint.
+	QCRHS = gurobi.QConstrDoubleAttr{Name: "QCRHS"}
+	// QCName: Quadratic constraint name.
+	QCName = gurobi.QConstrStringAttr{Name: "QCName"}
+	// QCTag: Tag used to identify the quadratic constraint in JSON solution files.
+	QCTag = gurobi.QConstrStringAttr{Name: "QCTag"}
+	// QCPi: Dual value of the quadratic constraint (read-only).
+	QCPi = gurobi.ReadOnlyQConstrDoubleAttr{Name: "QCPi"}
+	// QCSlack: Slack of the quadratic constraint in the current solution (read-only).
+	QCSlack = gurobi.ReadOnlyQConstrDoubleAttr{Name: "QCSlack"}
+	// IISQConstr: Whether the quadratic constraint participates in the IIS (read-only).
+	IISQConstr = gurobi.ReadOnlyQConstrIntAttr{Name: "IISQConstr"}
+	// IISQConstrForce: Forces the quadratic constraint to be included in or excluded from the IIS.
+	IISQConstrForce = gurobi.QConstrIntAttr{Name: "IISQConstrForce"}
+)
+
+// General constraint attributes
+var (
+	// GenConstrType: Type of the general constraint (read-only).
+	GenConstrType = gurobi.ReadOnlyGenConstrIntAttr{Name: "GenConstrType"}
+	// GenConstrName: General constraint name.
+	GenConstrName = gurobi.GenConstrStringAttr{Name: "GenConstrName"}
+	// FuncPieces: Strategy for the piecewise-linear approximation of a function constraint.
+	FuncPieces = gurobi.GenConstrIntAttr{Name: "FuncPieces"}
+	// FuncPieceLength: Length of each piece of the piecewise-linear approximation.
+	FuncPieceLength = gurobi.GenConstrDoubleAttr{Name: "FuncPieceLength"}
+	// FuncPieceError: Maximum error of the piecewise-linear approximation.
+	FuncPieceError = gurobi.GenConstrDoubleAttr{Name: "FuncPieceError"}
+	// FuncPieceRatio: Whether the piecewise-linear approximation under- or overestimates the function.
+	FuncPieceRatio = gurobi.GenConstrDoubleAttr{Name: "FuncPieceRatio"}
+	// FuncNonlinear: Whether the function constraint is handled as a nonlinear constraint instead of approximated.
+	FuncNonlinear = gurobi.GenConstrIntAttr{Name: "FuncNonlinear"}
+	// IISGenConstr: Whether the general constraint participates in the IIS (read-only).
+	IISGenConstr = gurobi.ReadOnlyGenConstrIntAttr{Name: "IISGenConstr"}
+	// IISGenConstrForce: Forces the general constraint to be included in or excluded from the IIS.
+	IISGenConstrForce = gurobi.GenConstrIntAttr{Name: "IISGenConstrForce"}
+)
+
+// SOS constraint attributes
+var (
+	// IISSOS: Whether the SOS constraint participates in the IIS (read-only).
+	IISSOS = gurobi.ReadOnlySOSIntAttr{Name: "IISSOS"}
+	// IISSOSForce: Forces the SOS constraint to be included in or excluded from the IIS.
+	IISSOSForce = gurobi.SOSIntAttr{Name: "IISSOSForce"}
+)
+
+// Catalog lists every attribute in this package.
+var Catalog = []Info{
+	{Name: "NumConstrs", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of linear constraints"},
+	{Name: "NumVars", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of variables"},
+	{Name: "NumSOS", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of SOS constraints"},
+	{Name: "NumQConstrs", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of quadratic constraints"},
+	{Name: "NumGenConstrs", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of general constraints"},
+	{Name: "NumNZs", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of nonzero coefficients in the linear constraints"},
+	{Name: "DNumNZs", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Number of nonzero coefficients in the linear constraints (as a double)"},
+	{Name: "NumQNZs", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of nonzero quadratic objective terms"},
+	{Name: "NumQCNZs", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of nonzero terms in the quadratic constraints"},
+	{Name: "NumIntVars", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of integer variables"},
+	{Name: "NumBinVars", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of binary variables"},
+	{Name: "NumPWLObjVars", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of variables with piecewise-linear objective functions"},
+	{Name: "ModelName", Object: ObjectModel, Type: TypeString, Settable: true, Description: "Model name"},
+	{Name: "ModelSense", Object: ObjectModel, Type: TypeInt, Settable: true, Description: "Model sense (minimization or maximization)"},
+	{Name: "ObjCon", Object: ObjectModel, Type: TypeDouble, Settable: true, Description: "Constant offset of the objective function"},
+	{Name: "Fingerprint", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Fingerprint of the model data and parameters"},
+	{Name: "ObjVal", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Objective value of the current solution"},
+	{Name: "ObjBound", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Best known bound on the optimal objective"},
+	{Name: "ObjBoundC", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Best known bound on the optimal objective (not rounded)"},
+	{Name: "PoolObjBound", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Bound on the objective of undiscovered MIP solutions"},
+	{Name: "PoolObjVal", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Objective value of the solution selected by SolutionNumber"},
+	{Name: "MIPGap", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Current relative MIP optimality gap"},
+	{Name: "Runtime", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Runtime of the most recent optimization in seconds"},
+	{Name: "Work", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Work spent on the most recent optimization in work units"},
+	{Name: "Status", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Current optimization status"},
+	{Name: "SolCount", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of stored solutions"},
+	{Name: "IterCount", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Number of simplex iterations performed"},
+	{Name: "BarIterCount", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of barrier iterations performed"},
+	{Name: "NodeCount", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Number of branch-and-cut nodes explored"},
+	{Name: "OpenNodeCount", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Number of unexplored branch-and-cut nodes"},
+	{Name: "IsMIP", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Whether the model has discrete elements"},
+	{Name: "IsQP", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Whether the model has a quadratic objective"},
+	{Name: "IsQCP", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Whether the model has quadratic constraints"},
+	{Name: "IsMultiObj", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Whether the model has multiple objectives"},
+	{Name: "IISMinimal", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Whether the computed IIS is minimal"},
+	{Name: "MaxCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Largest absolute value of a constraint coefficient"},
+	{Name: "MinCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Smallest absolute value of a nonzero constraint coefficient"},
+	{Name: "MaxBound", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Largest finite absolute variable bound"},
+	{Name: "MinBound", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Smallest nonzero absolute variable bound"},
+	{Name: "MaxObjCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Largest absolute value of a linear objective coefficient"},
+	{Name: "MinObjCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Smallest absolute value of a nonzero linear objective coefficient"},
+	{Name: "MaxRHS", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Largest absolute value of a linear constraint right-hand side"},
+	{Name: "MinRHS", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Smallest absolute value of a nonzero linear constraint right-hand side"},
+	{Name: "MaxQCCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Largest absolute value of a quadratic constraint coefficient"},
+	{Name: "MinQCCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Smallest absolute value of a nonzero quadratic constraint coefficient"},
+	{Name: "MaxQCLCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Largest absolute value of a linear coefficient in a quadratic constraint"},
+	{Name: "MinQCLCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Smallest absolute value of a nonzero linear coefficient in a quadratic constraint"},
+	{Name: "MaxQCRHS", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Largest absolute value of a quadratic constraint right-hand side"},
+	{Name: "MinQCRHS", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Smallest absolute value of a nonzero quadratic constraint right-hand side"},
+	{Name: "MaxQObjCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Largest absolute value of a quadratic objective coefficient"},
+	{Name: "MinQObjCoeff", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Smallest absolute value of a nonzero quadratic objective coefficient"},
+	{Name: "Kappa", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Estimated condition number of the optimal basis matrix"},
+	{Name: "KappaExact", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Exact condition number of the optimal basis matrix"},
+	{Name: "FarkasProof", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Magnitude of the infeasibility proven by the Farkas certificate"},
+	{Name: "NumStart", Object: ObjectModel, Type: TypeInt, Settable: true, Description: "Number of MIP starts"},
+	{Name: "NumObj", Object: ObjectModel, Type: TypeInt, Settable: true, Description: "Number of objectives"},
+	{Name: "NumScenarios", Object: ObjectModel, Type: TypeInt, Settable: true, Description: "Number of scenarios"},
+	{Name: "ConcurrentWinMethod", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Method that won the most recent concurrent optimization"},
+	{Name: "LicenseExpiration", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "License expiration date"},
+	{Name: "MemUsed", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Memory currently used (in GB)"},
+	{Name: "MaxMemUsed", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum memory used so far (in GB)"},
+	{Name: "TuneResultCount", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Number of parameter sets found by the tuning tool"},
+	{Name: "JobID", Object: ObjectModel, Type: TypeString, Settable: false, Description: "Job ID of a Compute Server or Instant Cloud job"},
+	{Name: "ObjNCon", Object: ObjectModel, Type: TypeDouble, Settable: true, Description: "Constant offset of the objective selected by ObjNumber"},
+	{Name: "ObjNPriority", Object: ObjectModel, Type: TypeInt, Settable: true, Description: "Priority of the objective selected by ObjNumber"},
+	{Name: "ObjNWeight", Object: ObjectModel, Type: TypeDouble, Settable: true, Description: "Weight of the objective selected by ObjNumber"},
+	{Name: "ObjNRelTol", Object: ObjectModel, Type: TypeDouble, Settable: true, Description: "Relative degradation allowed for the objective selected by ObjNumber"},
+	{Name: "ObjNAbsTol", Object: ObjectModel, Type: TypeDouble, Settable: true, Description: "Absolute degradation allowed for the objective selected by ObjNumber"},
+	{Name: "ObjNVal", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Value of the objective selected by ObjNumber in the solution selected by SolutionNumber"},
+	{Name: "ObjNName", Object: ObjectModel, Type: TypeString, Settable: true, Description: "Name of the objective selected by ObjNumber"},
+	{Name: "ScenNName", Object: ObjectModel, Type: TypeString, Settable: true, Description: "Name of the scenario selected by ScenarioNumber"},
+	{Name: "ScenNObjBound", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Objective bound of the scenario selected by ScenarioNumber"},
+	{Name: "ScenNObjVal", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Objective value of the scenario selected by ScenarioNumber"},
+	{Name: "BoundVio", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (unscaled) bound violation"},
+	{Name: "BoundSVio", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (scaled) bound violation"},
+	{Name: "BoundVioIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the variable with the largest (unscaled) bound violation"},
+	{Name: "BoundSVioIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the variable with the largest (scaled) bound violation"},
+	{Name: "BoundVioSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (unscaled) bound violations"},
+	{Name: "BoundSVioSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (scaled) bound violations"},
+	{Name: "ConstrVio", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (unscaled) constraint violation"},
+	{Name: "ConstrSVio", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (scaled) constraint violation"},
+	{Name: "ConstrVioIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the constraint with the largest (unscaled) violation"},
+	{Name: "ConstrSVioIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the constraint with the largest (scaled) violation"},
+	{Name: "ConstrVioSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (unscaled) constraint violations"},
+	{Name: "ConstrSVioSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (scaled) constraint violations"},
+	{Name: "ConstrResidual", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (unscaled) primal constraint residual"},
+	{Name: "ConstrSResidual", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (scaled) primal constraint residual"},
+	{Name: "ConstrResidualIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the constraint with the largest (unscaled) primal residual"},
+	{Name: "ConstrSResidualIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the constraint with the largest (scaled) primal residual"},
+	{Name: "ConstrResidualSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (unscaled) primal constraint residuals"},
+	{Name: "ConstrSResidualSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (scaled) primal constraint residuals"},
+	{Name: "DualVio", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (unscaled) reduced cost violation"},
+	{Name: "DualSVio", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (scaled) reduced cost violation"},
+	{Name: "DualVioIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the variable with the largest (unscaled) reduced cost violation"},
+	{Name: "DualSVioIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the variable with the largest (scaled) reduced cost violation"},
+	{Name: "DualVioSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (unscaled) reduced cost violations"},
+	{Name: "DualSVioSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (scaled) reduced cost violations"},
+	{Name: "DualResidual", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (unscaled) dual constraint residual"},
+	{Name: "DualSResidual", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum (scaled) dual constraint residual"},
+	{Name: "DualResidualIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the variable with the largest (unscaled) dual residual"},
+	{Name: "DualSResidualIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the variable with the largest (scaled) dual residual"},
+	{Name: "DualResidualSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (unscaled) dual constraint residuals"},
+	{Name: "DualSResidualSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of (scaled) dual constraint residuals"},
+	{Name: "ComplVio", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum complementarity violation"},
+	{Name: "ComplVioIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the variable with the largest complementarity violation"},
+	{Name: "ComplVioSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of complementarity violations"},
+	{Name: "IntVio", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Maximum integrality violation"},
+	{Name: "IntVioIndex", Object: ObjectModel, Type: TypeInt, Settable: false, Description: "Index of the variable with the largest integrality violation"},
+	{Name: "IntVioSum", Object: ObjectModel, Type: TypeDouble, Settable: false, Description: "Sum of integrality violations"},
+	{Name: "LB", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Lower bound"},
+	{Name: "UB", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Upper bound"},
+	{Name: "Obj", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Linear objective coefficient"},
+	{Name: "VType", Object: ObjectVar, Type: TypeChar, Settable: true, Description: "Variable type (continuous/binary/integer/semi-continuous/semi-integer)"},
+	{Name: "VarName", Object: ObjectVar, Type: TypeString, Settable: true, Description: "Variable name"},
+	{Name: "VTag", Object: ObjectVar, Type: TypeString, Settable: true, Description: "Tag used to identify the variable in JSON solution files"},
+	{Name: "X", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Value in the current solution"},
+	{Name: "Xn", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Value in the solution selected by SolutionNumber"},
+	{Name: "RC", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Reduced cost"},
+	{Name: "BarX", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Value in the best barrier iterate (before crossover)"},
+	{Name: "Start", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Value in the current MIP start"},
+	{Name: "VarHintVal", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Hint for the value of the variable in a MIP solution"},
+	{Name: "VarHintPri", Object: ObjectVar, Type: TypeInt, Settable: true, Description: "Priority of the variable hint"},
+	{Name: "BranchPriority", Object: ObjectVar, Type: TypeInt, Settable: true, Description: "Branching priority"},
+	{Name: "Partition", Object: ObjectVar, Type: TypeInt, Settable: true, Description: "Partition used by the partition heuristic"},
+	{Name: "VBasis", Object: ObjectVar, Type: TypeInt, Settable: true, Description: "Status of the variable in the current basis"},
+	{Name: "PStart", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Value in the primal simplex start vector"},
+	{Name: "PreFixVal", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Value used to fix the variable in presolve"},
+	{Name: "PWLObjCvx", Object: ObjectVar, Type: TypeInt, Settable: false, Description: "Whether the piecewise-linear objective function is convex"},
+	{Name: "UnbdRay", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Value in the unbounded ray"},
+	{Name: "SAObjLow", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Smallest objective coefficient for which the basis stays optimal"},
+	{Name: "SAObjUp", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Largest objective coefficient for which the basis stays optimal"},
+	{Name: "SALBLow", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Smallest lower bound for which the basis stays optimal"},
+	{Name: "SALBUp", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Largest lower bound for which the basis stays optimal"},
+	{Name: "SAUBLow", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Smallest upper bound for which the basis stays optimal"},
+	{Name: "SAUBUp", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Largest upper bound for which the basis stays optimal"},
+	{Name: "IISLB", Object: ObjectVar, Type: TypeInt, Settable: false, Description: "Whether the lower bound participates in the IIS"},
+	{Name: "IISUB", Object: ObjectVar, Type: TypeInt, Settable: false, Description: "Whether the upper bound participates in the IIS"},
+	{Name: "IISLBForce", Object: ObjectVar, Type: TypeInt, Settable: true, Description: "Forces the lower bound to be included in or excluded from the IIS"},
+	{Name: "IISUBForce", Object: ObjectVar, Type: TypeInt, Settable: true, Description: "Forces the upper bound to be included in or excluded from the IIS"},
+	{Name: "ObjN", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Coefficient in the objective selected by ObjNumber"},
+	{Name: "ScenNLB", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Lower bound in the scenario selected by ScenarioNumber"},
+	{Name: "ScenNUB", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Upper bound in the scenario selected by ScenarioNumber"},
+	{Name: "ScenNObj", Object: ObjectVar, Type: TypeDouble, Settable: true, Description: "Objective coefficient in the scenario selected by ScenarioNumber"},
+	{Name: "ScenNX", Object: ObjectVar, Type: TypeDouble, Settable: false, Description: "Value in the solution of the scenario selected by ScenarioNumber"},
+	{Name: "PoolIgnore", Object: ObjectVar, Type: TypeInt, Settable: true, Description: "Whether the variable is ignored when deciding if two pool solutions differ"},
+	{Name: "Sense", Object: ObjectConstr, Type: TypeChar, Settable: true, Description: "Constraint sense"},
+	{Name: "RHS", Object: ObjectConstr, Type: TypeDouble, Settable: true, Description: "Right-hand side"},
+	{Name: "ConstrName", Object: ObjectConstr, Type: TypeString, Settable: true, Description: "Constraint name"},
+	{Name: "CTag", Object: ObjectConstr, Type: TypeString, Settable: true, Description: "Tag used to identify the constraint in JSON solution files"},
+	{Name: "Lazy", Object: ObjectConstr, Type: TypeInt, Settable: true, Description: "Whether the constraint is treated as a lazy constraint or a user cut"},
+	{Name: "Pi", Object: ObjectConstr, Type: TypeDouble, Settable: false, Description: "Dual value (shadow price)"},
+	{Name: "Slack", Object: ObjectConstr, Type: TypeDouble, Settable: false, Description: "Slack in the current solution"},
+	{Name: "CBasis", Object: ObjectConstr, Type: TypeInt, Settable: true, Description: "Status of the constraint in the current basis"},
+	{Name: "DStart", Object: ObjectConstr, Type: TypeDouble, Settable: true, Description: "Value in the dual simplex start vector"},
+	{Name: "FarkasDual", Object: ObjectConstr, Type: TypeDouble, Settable: false, Description: "Farkas infeasibility certificate"},
+	{Name: "SARHSLow", Object: ObjectConstr, Type: TypeDouble, Settable: false, Description: "Smallest right-hand side for which the basis stays optimal"},
+	{Name: "SARHSUp", Object: ObjectConstr, Type: TypeDouble, Settable: false, Description: "Largest right-hand side for which the basis stays optimal"},
+	{Name: "IISConstr", Object: ObjectConstr, Type: TypeInt, Settable: false, Description: "Whether the constraint participates in the IIS"},
+	{Name: "IISConstrForce", Object: ObjectConstr, Type: TypeInt, Settable: true, Description: "Forces the constraint to be included in or excluded from the IIS"},
+	{Name: "ScenNRHS", Object: ObjectConstr, Type: TypeDouble, Settable: true, Description: "Right-hand side in the scenario selected by ScenarioNumber"},
+	{Name: "QCSense", Object: ObjectQConstr, Type: TypeChar, Settable: true, Description: "Quadratic constraint sense"},
+	{Name: "QCRHS", Object: ObjectQConstr, Type: TypeDouble, Settable: true, Description: "Right-hand side of the quadratic constraint"},
+	{Name: "QCName", Object: ObjectQConstr, Type: TypeString, Settable: true, Description: "Quadratic constraint name"},
+	{Name: "QCTag", Object: ObjectQConstr, Type: TypeString, Settable: true, Description: "Tag used to identify the quadratic constraint in JSON solution files"},
+	{Name: "QCPi", Object: ObjectQConstr, Type: TypeDouble, Settable: false, Description: "Dual value of the quadratic constraint"},
+	{Name: "QCSlack", Object: ObjectQConstr, Type: TypeDouble, Settable: false, Description: "Slack of the quadratic constraint in the current solution"},
+	{Name: "IISQConstr", Object: ObjectQConstr, Type: TypeInt, Settable: false, Description: "Whether the quadratic constraint participates in the IIS"},
+	{Name: "IISQConstrForce", Object: ObjectQConstr, Type: TypeInt, Settable: true, Description: "Forces the quadratic constraint to be included in or excluded from the IIS"},
+	{Name: "GenConstrType", Object: ObjectGenConstr, Type: TypeInt, Settable: false, Description: "Type of the general constraint"},
+	{Name: "GenConstrName", Object: ObjectGenConstr, Type: TypeString, Settable: true, Description: "General constraint name"},
+	{Name: "FuncPieces", Object: ObjectGenConstr, Type: TypeInt, Settable: true, Description: "Strategy for the piecewise-linear approximation of a function constraint"},
+	{Name: "FuncPieceLength", Object: ObjectGenConstr, Type: TypeDouble, Settable: true, Description: "Length of each piece of the piecewise-linear approximation"},
+	{Name: "FuncPieceError", Object: ObjectGenConstr, Type: TypeDouble, Settable: true, Description: "Maximum error of the piecewise-linear approximation"},
+	{Name: "FuncPieceRatio", Object: ObjectGenConstr, Type: TypeDouble, Settable: true, Description: "Whether the piecewise-linear approximation under- or overestimates the function"},
+	{Name: "FuncNonlinear", Object: ObjectGenConstr, Type: TypeInt, Settable: true, Description: "Whether the function constraint is handled as a nonlinear constraint instead of approximated"},
+	{Name: "IISGenConstr", Object: ObjectGenConstr, Type: TypeInt, Settable: false, Description: "Whether the general constraint participates in the IIS"},
+	{Name: "IISGenConstrForce", Object: ObjectGenConstr, Type: TypeInt, Settable: true, Description: "Forces the general constraint to be included in or excluded from the IIS"},
+	{Name: "IISSOS", Object: ObjectSOS, Type: TypeInt, Settable: false, Description: "Whether the SOS constraint participates in the IIS"},
+	{Name: "IISSOSForce", Object: ObjectSOS, Type: TypeInt, Settable: true, Description: "Forces the SOS constraint to be included in or excluded from the IIS"},
+}
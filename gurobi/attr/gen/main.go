@@ -0,0 +1,200 @@
+/*
+main.go
+Description:
+	Generates the attribute descriptors and the Catalog of the attr package from a CSV file
+	with the columns object, name, type, settable and description.
+	Run it through go generate in the attr directory.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"text/template"
+)
+
+type attribute struct {
+	Object      string
+	Name        string
+	Type        string
+	Settable    bool
+	Description string
+}
+
+// Go type names in the gurobi package for each object and value type. The descriptors of
+// read-only attributes use the same names with a ReadOnly prefix (e.g. ReadOnlyVarDoubleAttr).
+var descriptorTypes = map[string]map[string]string{
+	"model":     {"int": "ModelIntAttr", "double": "ModelDoubleAttr", "string": "ModelStringAttr"},
+	"var":       {"int": "VarIntAttr", "char": "VarCharAttr", "double": "VarDoubleAttr", "string": "VarStringAttr"},
+	"constr":    {"int": "ConstrIntAttr", "char": "ConstrCharAttr", "double": "ConstrDoubleAttr", "string": "ConstrStringAttr"},
+	"qconstr":   {"int": "QConstrIntAttr", "char": "QConstrCharAttr", "double": "QConstrDoubleAttr", "string": "QConstrStringAttr"},
+	"genconstr": {"int": "GenConstrIntAttr", "double": "GenConstrDoubleAttr", "string": "GenConstrStringAttr"},
+	"sos":       {"int": "SOSIntAttr"},
+}
+
+var sections = []struct {
+	Object string
+	Title  string
+}{
+	{"model", "Model attributes"},
+	{"var", "Variable attributes"},
+	{"constr", "Linear constraint attributes"},
+	{"qconstr", "Quadratic constraint attributes"},
+	{"genconstr", "General constraint attributes"},
+	{"sos", "SOS constraint attributes"},
+}
+
+var objectConsts = map[string]string{
+	"model":     "ObjectModel",
+	"var":       "ObjectVar",
+	"constr":    "ObjectConstr",
+	"qconstr":   "ObjectQConstr",
+	"genconstr": "ObjectGenConstr",
+	"sos":       "ObjectSOS",
+}
+
+var typeConsts = map[string]string{
+	"int":    "TypeInt",
+	"char":   "TypeChar",
+	"double": "TypeDouble",
+	"string": "TypeString",
+}
+
+const outputTemplate = `// Code generated by "go run ./gen"; DO NOT EDIT.
+
+package attr
+
+import "github.com/MatProGo-dev/Gurobi.go/gurobi"
+{{range .Sections}}
+// {{.Title}}
+var (
+{{- range .Attributes}}
+	// {{.Name}}: {{.Description}}{{if not .Settable}} (read-only){{end}}.
+	{{.Name}} = gurobi.{{descriptor .}}{Name: "{{.Name}}"}
+{{- end}}
+)
+{{end}}
+// Catalog lists every attribute in this package.
+var Catalog = []Info{
+{{- range .All}}
+	{Name: "{{.Name}}", Object: {{objectConst .}}, Type: {{typeConst .}}, Settable: {{.Settable}}, Description: {{printf "%q" .Description}}},
+{{- end}}
+}
+`
+
+func main() {
+	in := flag.String("in", "attributes.csv", "CSV file with the attribute reference")
+	out := flag.String("out", "attributes.go", "Go file to generate")
+	flag.Parse()
+
+	attributes, err := readAttributes(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	type section struct {
+		Title      string
+		Attributes []attribute
+	}
+	data := struct {
+		Sections []section
+		All      []attribute
+	}{All: attributes}
+	for _, s := range sections {
+		sec := section{Title: s.Title}
+		for _, a := range attributes {
+			if a.Object == s.Object {
+				sec.Attributes = append(sec.Attributes, a)
+			}
+		}
+		data.Sections = append(data.Sections, sec)
+	}
+
+	tmpl := template.Must(template.New("attributes").Funcs(template.FuncMap{
+		"descriptor":  descriptor,
+		"objectConst": func(a attribute) string { return objectConsts[a.Object] },
+		"typeConst":   func(a attribute) string { return typeConsts[a.Type] },
+	}).Parse(outputTemplate))
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("generated code does not compile: %v", err)
+	}
+
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+/*
+descriptor
+Description:
+
+	Returns the name of the descriptor type of a in the gurobi package.
+*/
+func descriptor(a attribute) string {
+	if !a.Settable {
+		return "ReadOnly" + descriptorTypes[a.Object][a.Type]
+	}
+	return descriptorTypes[a.Object][a.Type]
+}
+
+/*
+readAttributes
+Description:
+
+	Reads and checks the rows of the attribute CSV file.
+*/
+func readAttributes(filename string) ([]attribute, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var attributes []attribute
+	for i, record := range records[1:] {
+		settable, err := strconv.ParseBool(record[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %v", i+2, err)
+		}
+
+		a := attribute{
+			Object:      record[0],
+			Name:        record[1],
+			Type:        record[2],
+			Settable:    settable,
+			Description: record[4],
+		}
+
+		if _, ok := descriptorTypes[a.Object][a.Type]; !ok {
+			return nil, fmt.Errorf("line %v: unsupported %v attribute type %v", i+2, a.Object, a.Type)
+		}
+		if seen[a.Name] {
+			return nil, fmt.Errorf("line %v: duplicate attribute %v", i+2, a.Name)
+		}
+		seen[a.Name] = true
+
+		attributes = append(attributes, a)
+	}
+
+	return attributes, nil
+}
@@ -0,0 +1,266 @@
+package gurobi
+
+import "fmt"
+
+/*
+attribute.go
+Description:
+	Typed descriptors for Gurobi attributes.
+	Each descriptor records which kind of object the attribute belongs to (model, variable,
+	linear, quadratic, general or SOS constraint), the Go type of its value and whether it can
+	be set, so that using the wrong getter or setting a read-only attribute is a compile-time
+	error instead of a runtime error from Gurobi.
+	The descriptors for all of Gurobi's attributes are defined in the attr package, e.g.
+
+		x, err := gurobi.GetVarAttr(v, attr.X)
+		numVars, err := gurobi.GetModelAttr(model, attr.NumVars)
+Notes:
+	Char attributes (e.g. VType or Sense) use int8, like GetChar and SetChar.
+*/
+
+// AttrValue lists the Go types that attribute values can have.
+type AttrValue interface {
+	int32 | int8 | float64 | string
+}
+
+// ModelAttrValue lists the Go types that model attributes can have (there are no model char attributes).
+type ModelAttrValue interface {
+	int32 | float64 | string
+}
+
+// AttrAccess lists the markers that tell whether an attribute can be set.
+type AttrAccess interface {
+	AttrSettable | AttrReadOnly
+}
+
+// AttrSettable marks the descriptors of attributes that can be queried and set (e.g. Obj).
+type AttrSettable struct{}
+
+// AttrReadOnly marks the descriptors of attributes that can only be queried (e.g. X);
+// passing them to one of the Set*Attr functions does not compile.
+type AttrReadOnly struct{}
+
+// ModelAttr describes a scalar attribute of a model with values of type T.
+type ModelAttr[T ModelAttrValue, A AttrAccess] struct {
+	Name string
+}
+
+// VarAttr describes an attribute of each variable with values of type T.
+type VarAttr[T AttrValue, A AttrAccess] struct {
+	Name string
+}
+
+// ConstrAttr describes an attribute of each linear constraint with values of type T.
+type ConstrAttr[T AttrValue, A AttrAccess] struct {
+	Name string
+}
+
+// QConstrAttr describes an attribute of each quadratic constraint with values of type T.
+type QConstrAttr[T AttrValue, A AttrAccess] struct {
+	Name string
+}
+
+// GenConstrAttr describes an attribute of each general constraint with values of type T.
+type GenConstrAttr[T AttrValue, A AttrAccess] struct {
+	Name string
+}
+
+// SOSAttr describes an attribute of each SOS constraint with values of type T.
+type SOSAttr[T AttrValue, A AttrAccess] struct {
+	Name string
+}
+
+type ModelIntAttr = ModelAttr[int32, AttrSettable]
+type ModelDoubleAttr = ModelAttr[float64, AttrSettable]
+type ModelStringAttr = ModelAttr[string, AttrSettable]
+type ReadOnlyModelIntAttr = ModelAttr[int32, AttrReadOnly]
+type ReadOnlyModelDoubleAttr = ModelAttr[float64, AttrReadOnly]
+type ReadOnlyModelStringAttr = ModelAttr[string, AttrReadOnly]
+
+type VarIntAttr = VarAttr[int32, AttrSettable]
+type VarCharAttr = VarAttr[int8, AttrSettable]
+type VarDoubleAttr = VarAttr[float64, AttrSettable]
+type VarStringAttr = VarAttr[string, AttrSettable]
+type ReadOnlyVarIntAttr = VarAttr[int32, AttrReadOnly]
+type ReadOnlyVarCharAttr = VarAttr[int8, AttrReadOnly]
+type ReadOnlyVarDoubleAttr = VarAttr[float64, AttrReadOnly]
+type ReadOnlyVarStringAttr = VarAttr[string, AttrReadOnly]
+
+type ConstrIntAttr = ConstrAttr[int32, AttrSettable]
+type ConstrCharAttr = ConstrAttr[int8, AttrSettable]
+type ConstrDoubleAttr = ConstrAttr[float64, AttrSettable]
+type ConstrStringAttr = ConstrAttr[string, AttrSettable]
+type ReadOnlyConstrIntAttr = ConstrAttr[int32, AttrReadOnly]
+type ReadOnlyConstrCharAttr = ConstrAttr[int8, AttrReadOnly]
+type ReadOnlyConstrDoubleAttr = ConstrAttr[float64, AttrReadOnly]
+type ReadOnlyConstrStringAttr = ConstrAttr[string, AttrReadOnly]
+
+type QConstrIntAttr = QConstrAttr[int32, AttrSettable]
+type QConstrCharAttr = QConstrAttr[int8, AttrSettable]
+type QConstrDoubleAttr = QConstrAttr[float64, AttrSettable]
+type QConstrStringAttr = QConstrAttr[string, AttrSettable]
+type ReadOnlyQConstrIntAttr = QConstrAttr[int32, AttrReadOnly]
+type ReadOnlyQConstrCharAttr = QConstrAttr[int8, AttrReadOnly]
+type ReadOnlyQConstrDoubleAttr = QConstrAttr[float64, AttrReadOnly]
+type ReadOnlyQConstrStringAttr = QConstrAttr[string, AttrReadOnly]
+
+type GenConstrIntAttr = GenConstrAttr[int32, AttrSettable]
+type GenConstrDoubleAttr = GenConstrAttr[float64, AttrSettable]
+type GenConstrStringAttr = GenConstrAttr[string, AttrSettable]
+type ReadOnlyGenConstrIntAttr = GenConstrAttr[int32, AttrReadOnly]
+type ReadOnlyGenConstrDoubleAttr = GenConstrAttr[float64, AttrReadOnly]
+type ReadOnlyGenConstrStringAttr = GenConstrAttr[string, AttrReadOnly]
+
+type SOSIntAttr = SOSAttr[int32, AttrSettable]
+type ReadOnlySOSIntAttr = SOSAttr[int32, AttrReadOnly]
+
+/*
+GetModelAttr
+Description:
+
+	Returns the value of the model attribute a.
+*/
+func GetModelAttr[T ModelAttrValue, A AttrAccess](model *Model, a ModelAttr[T, A]) (T, error) {
+	var value T
+	err := model.Check()
+	if err != nil {
+		return value, model.MakeUninitializedError()
+	}
+
+	var out interface{}
+	switch any(value).(type) {
+	case int32:
+		out, err = model.GetIntAttr(a.Name)
+	case float64:
+		out, err = model.GetDoubleAttr(a.Name)
+	case string:
+		out, err = model.GetStringAttr(a.Name)
+	default:
+		return value, fmt.Errorf("model attribute %v has unsupported type %T", a.Name, value)
+	}
+	if err != nil {
+		return value, err
+	}
+
+	return out.(T), nil
+}
+
+/*
+SetModelAttr
+Description:
+
+	Sets the value of the model attribute a. Only settable attributes are accepted.
+*/
+func SetModelAttr[T ModelAttrValue](model *Model, a ModelAttr[T, AttrSettable], value T) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	switch v := any(value).(type) {
+	case int32:
+		return model.SetIntAttr(a.Name, v)
+	case float64:
+		return model.SetDoubleAttr(a.Name, v)
+	case string:
+		return model.SetStringAttr(a.Name, v)
+	default:
+		return fmt.Errorf("model attribute %v has unsupported type %T", a.Name, value)
+	}
+}
+
+func GetVarAttr[T AttrValue, A AttrAccess](v *Var, a VarAttr[T, A]) (T, error) {
+	return getAttrElement[T](v.Model, a.Name, v.Index)
+}
+
+func SetVarAttr[T AttrValue](v *Var, a VarAttr[T, AttrSettable], value T) error {
+	return setAttrElement(v.Model, a.Name, v.Index, value)
+}
+
+func GetConstrAttr[T AttrValue, A AttrAccess](c *Constr, a ConstrAttr[T, A]) (T, error) {
+	return getAttrElement[T](c.Model, a.Name, c.Index)
+}
+
+func SetConstrAttr[T AttrValue](c *Constr, a ConstrAttr[T, AttrSettable], value T) error {
+	return setAttrElement(c.Model, a.Name, c.Index, value)
+}
+
+func GetQConstrAttr[T AttrValue, A AttrAccess](qc *QConstr, a QConstrAttr[T, A]) (T, error) {
+	return getAttrElement[T](qc.Model, a.Name, qc.Index)
+}
+
+func SetQConstrAttr[T AttrValue](qc *QConstr, a QConstrAttr[T, AttrSettable], value T) error {
+	return setAttrElement(qc.Model, a.Name, qc.Index, value)
+}
+
+func GetGenConstrAttr[T AttrValue, A AttrAccess](gc *GenConstr, a GenConstrAttr[T, A]) (T, error) {
+	return getAttrElement[T](gc.Model, a.Name, gc.Index)
+}
+
+func SetGenConstrAttr[T AttrValue](gc *GenConstr, a GenConstrAttr[T, AttrSettable], value T) error {
+	return setAttrElement(gc.Model, a.Name, gc.Index, value)
+}
+
+func GetSOSAttr[T AttrValue, A AttrAccess](sos *SOS, a SOSAttr[T, A]) (T, error) {
+	return getAttrElement[T](sos.Model, a.Name, sos.Index)
+}
+
+func SetSOSAttr[T AttrValue](sos *SOS, a SOSAttr[T, AttrSettable], value T) error {
+	return setAttrElement(sos.Model, a.Name, sos.Index, value)
+}
+
+/*
+getAttrElement
+Description:
+
+	Retrieves element ind of the array attribute attrName using the getter that matches T.
+*/
+func getAttrElement[T AttrValue](model *Model, attrName string, ind int32) (T, error) {
+	var value T
+	err := model.Check()
+	if err != nil {
+		return value, model.MakeUninitializedError()
+	}
+
+	var out interface{}
+	switch any(value).(type) {
+	case int32:
+		out, err = model.getIntAttrElement(attrName, ind)
+	case int8:
+		out, err = model.getCharAttrElement(attrName, ind)
+	case float64:
+		out, err = model.getDoubleAttrElement(attrName, ind)
+	case string:
+		out, err = model.getStringAttrElement(attrName, ind)
+	}
+	if err != nil {
+		return value, err
+	}
+
+	return out.(T), nil
+}
+
+/*
+setAttrElement
+Description:
+
+	Sets element ind of the array attribute attrName using the setter that matches T.
+*/
+func setAttrElement[T AttrValue](model *Model, attrName string, ind int32, value T) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	switch v := any(value).(type) {
+	case int32:
+		return model.setIntAttrElement(attrName, ind, v)
+	case int8:
+		return model.setCharAttrElement(attrName, ind, v)
+	case float64:
+		return model.setDoubleAttrElement(attrName, ind, v)
+	case string:
+		return model.setStringAttrElement(attrName, ind, v)
+	}
+	return nil
+}
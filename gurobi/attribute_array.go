@@ -0,0 +1,772 @@
+package gurobi
+
+// #include <stdlib.h>
+// #include <gurobi_passthrough.h>
+import "C"
+import "unsafe"
+
+/*
+attribute_array.go
+Description:
+	Functions for reading and writing many elements of an array attribute with one call to the C api.
+	The Array functions work on a contiguous range of elements (e.g. the X value of every variable),
+	the List functions on an arbitrary list of indices and the Vars and Constrs functions on handles.
+	Prefer these over the element-wise getters (e.g. Var.GetDouble) when handling many elements.
+*/
+
+/*
+GetIntAttrArray
+Description:
+
+	Returns the values of elements start, ..., start+length-1 of the int array attribute attrname.
+	Uses the GRBgetintattrarray() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getintattrarray.html
+*/
+func (model *Model) GetIntAttrArray(attrname string, start int, length int) ([]int32, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	values := make([]int32, length)
+	if length == 0 {
+		return values, nil
+	}
+
+	errCode := C.GRBgetintattrarray(model.AsGRBModel, C.CString(attrname), C.int(start), C.int(length), (*C.int)(&values[0]))
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+	return values, nil
+}
+
+/*
+SetIntAttrArray
+Description:
+
+	Sets elements start, ..., start+len(values)-1 of the int array attribute attrname.
+	Uses the GRBsetintattrarray() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_setintattrarray.html
+*/
+func (model *Model) SetIntAttrArray(attrname string, start int, values []int32) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBsetintattrarray(model.AsGRBModel, C.CString(attrname), C.int(start), C.int(len(values)), (*C.int)(&values[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+GetIntAttrList
+Description:
+
+	Returns the values of the elements ind[0], ind[1], ... of the int array attribute attrname.
+	Uses the GRBgetintattrlist() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getintattrlist.html
+*/
+func (model *Model) GetIntAttrList(attrname string, ind []int32) ([]int32, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	values := make([]int32, len(ind))
+	if len(ind) == 0 {
+		return values, nil
+	}
+
+	errCode := C.GRBgetintattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.int)(&values[0]))
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+	return values, nil
+}
+
+/*
+SetIntAttrList
+Description:
+
+	Sets the elements ind[0], ind[1], ... of the int array attribute attrname to values[0], values[1], ...
+	Uses the GRBsetintattrlist() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_setintattrlist.html
+*/
+func (model *Model) SetIntAttrList(attrname string, ind []int32, values []int32) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	if len(ind) != len(values) {
+		return MismatchedLengthError{
+			Length1: len(ind),
+			Length2: len(values),
+			Name1:   "ind",
+			Name2:   "values",
+		}
+	}
+
+	if len(ind) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBsetintattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.int)(&values[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+GetCharAttrArray
+Description:
+
+	Returns the values of elements start, ..., start+length-1 of the char array attribute attrname.
+	Uses the GRBgetcharattrarray() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getcharattrarray.html
+*/
+func (model *Model) GetCharAttrArray(attrname string, start int, length int) ([]int8, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	values := make([]int8, length)
+	if length == 0 {
+		return values, nil
+	}
+
+	errCode := C.GRBgetcharattrarray(model.AsGRBModel, C.CString(attrname), C.int(start), C.int(length), (*C.char)(&values[0]))
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+	return values, nil
+}
+
+/*
+SetCharAttrArray
+Description:
+
+	Sets elements start, ..., start+len(values)-1 of the char array attribute attrname.
+	Uses the GRBsetcharattrarray() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_setcharattrarray.html
+*/
+func (model *Model) SetCharAttrArray(attrname string, start int, values []int8) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBsetcharattrarray(model.AsGRBModel, C.CString(attrname), C.int(start), C.int(len(values)), (*C.char)(&values[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+GetCharAttrList
+Description:
+
+	Returns the values of the elements ind[0], ind[1], ... of the char array attribute attrname.
+	Uses the GRBgetcharattrlist() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getcharattrlist.html
+*/
+func (model *Model) GetCharAttrList(attrname string, ind []int32) ([]int8, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	values := make([]int8, len(ind))
+	if len(ind) == 0 {
+		return values, nil
+	}
+
+	errCode := C.GRBgetcharattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.char)(&values[0]))
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+	return values, nil
+}
+
+/*
+SetCharAttrList
+Description:
+
+	Sets the elements ind[0], ind[1], ... of the char array attribute attrname to values[0], values[1], ...
+	Uses the GRBsetcharattrlist() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_setcharattrlist.html
+*/
+func (model *Model) SetCharAttrList(attrname string, ind []int32, values []int8) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	if len(ind) != len(values) {
+		return MismatchedLengthError{
+			Length1: len(ind),
+			Length2: len(values),
+			Name1:   "ind",
+			Name2:   "values",
+		}
+	}
+
+	if len(ind) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBsetcharattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.char)(&values[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+GetDoubleAttrArray
+Description:
+
+	Returns the values of elements start, ..., start+length-1 of the double array attribute attrname.
+	Uses the GRBgetdblattrarray() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getdblattrarray.html
+*/
+func (model *Model) GetDoubleAttrArray(attrname string, start int, length int) ([]float64, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	values := make([]float64, length)
+	if length == 0 {
+		return values, nil
+	}
+
+	errCode := C.GRBgetdblattrarray(model.AsGRBModel, C.CString(attrname), C.int(start), C.int(length), (*C.double)(&values[0]))
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+	return values, nil
+}
+
+/*
+SetDoubleAttrArray
+Description:
+
+	Sets elements start, ..., start+len(values)-1 of the double array attribute attrname.
+	Uses the GRBsetdblattrarray() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_setdblattrarray.html
+*/
+func (model *Model) SetDoubleAttrArray(attrname string, start int, values []float64) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBsetdblattrarray(model.AsGRBModel, C.CString(attrname), C.int(start), C.int(len(values)), (*C.double)(&values[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+GetDoubleAttrList
+Description:
+
+	Returns the values of the elements ind[0], ind[1], ... of the double array attribute attrname.
+	Uses the GRBgetdblattrlist() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getdblattrlist.html
+*/
+func (model *Model) GetDoubleAttrList(attrname string, ind []int32) ([]float64, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	values := make([]float64, len(ind))
+	if len(ind) == 0 {
+		return values, nil
+	}
+
+	errCode := C.GRBgetdblattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.double)(&values[0]))
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+	return values, nil
+}
+
+/*
+SetDoubleAttrList
+Description:
+
+	Sets the elements ind[0], ind[1], ... of the double array attribute attrname to values[0], values[1], ...
+	Uses the GRBsetdblattrlist() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_setdblattrlist.html
+*/
+func (model *Model) SetDoubleAttrList(attrname string, ind []int32, values []float64) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	if len(ind) != len(values) {
+		return MismatchedLengthError{
+			Length1: len(ind),
+			Length2: len(values),
+			Name1:   "ind",
+			Name2:   "values",
+		}
+	}
+
+	if len(ind) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBsetdblattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.double)(&values[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+GetStringAttrArray
+Description:
+
+	Returns the values of elements start, ..., start+length-1 of the string array attribute attrname.
+	Uses the GRBgetstrattrarray() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getstrattrarray.html
+*/
+func (model *Model) GetStringAttrArray(attrname string, start int, length int) ([]string, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	if length == 0 {
+		return []string{}, nil
+	}
+
+	// The C api returns pointers to strings that are owned by the model.
+	cValues := make([]*C.char, length)
+	errCode := C.GRBgetstrattrarray(model.AsGRBModel, C.CString(attrname), C.int(start), C.int(length), (**C.char)(&cValues[0]))
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+	return goStrings(cValues), nil
+}
+
+/*
+SetStringAttrArray
+Description:
+
+	Sets elements start, ..., start+len(values)-1 of the string array attribute attrname.
+	Uses the GRBsetstrattrarray() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_setstrattrarray.html
+*/
+func (model *Model) SetStringAttrArray(attrname string, start int, values []string) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	cValues := cStrings(values)
+	defer freeCStrings(cValues)
+
+	errCode := C.GRBsetstrattrarray(model.AsGRBModel, C.CString(attrname), C.int(start), C.int(len(values)), (**C.char)(&cValues[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+GetStringAttrList
+Description:
+
+	Returns the values of the elements ind[0], ind[1], ... of the string array attribute attrname.
+	Uses the GRBgetstrattrlist() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getstrattrlist.html
+*/
+func (model *Model) GetStringAttrList(attrname string, ind []int32) ([]string, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	if len(ind) == 0 {
+		return []string{}, nil
+	}
+
+	cValues := make([]*C.char, len(ind))
+	errCode := C.GRBgetstrattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (**C.char)(&cValues[0]))
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+	return goStrings(cValues), nil
+}
+
+/*
+SetStringAttrList
+Description:
+
+	Sets the elements ind[0], ind[1], ... of the string array attribute attrname to values[0], values[1], ...
+	Uses the GRBsetstrattrlist() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_setstrattrlist.html
+*/
+func (model *Model) SetStringAttrList(attrname string, ind []int32, values []string) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	if len(ind) != len(values) {
+		return MismatchedLengthError{
+			Length1: len(ind),
+			Length2: len(values),
+			Name1:   "ind",
+			Name2:   "values",
+		}
+	}
+
+	if len(ind) == 0 {
+		return nil
+	}
+
+	cValues := cStrings(values)
+	defer freeCStrings(cValues)
+
+	errCode := C.GRBsetstrattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (**C.char)(&cValues[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+GetIntAttrVars
+Description:
+
+	Returns the value of the int attribute attrname for each of the given vars.
+*/
+func (model *Model) GetIntAttrVars(attrname string, vars []*Var) ([]int32, error) {
+	ind, err := varIndices(vars)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetIntAttrList(attrname, ind)
+}
+
+/*
+SetIntAttrVars
+Description:
+
+	Sets the int attribute attrname of each of the given vars.
+*/
+func (model *Model) SetIntAttrVars(attrname string, vars []*Var, values []int32) error {
+	ind, err := varIndices(vars)
+	if err != nil {
+		return err
+	}
+	return model.SetIntAttrList(attrname, ind, values)
+}
+
+/*
+GetIntAttrConstrs
+Description:
+
+	Returns the value of the int attribute attrname for each of the given constrs.
+*/
+func (model *Model) GetIntAttrConstrs(attrname string, constrs []*Constr) ([]int32, error) {
+	ind, err := constrIndices(constrs)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetIntAttrList(attrname, ind)
+}
+
+/*
+SetIntAttrConstrs
+Description:
+
+	Sets the int attribute attrname of each of the given constrs.
+*/
+func (model *Model) SetIntAttrConstrs(attrname string, constrs []*Constr, values []int32) error {
+	ind, err := constrIndices(constrs)
+	if err != nil {
+		return err
+	}
+	return model.SetIntAttrList(attrname, ind, values)
+}
+
+/*
+GetCharAttrVars
+Description:
+
+	Returns the value of the char attribute attrname for each of the given vars.
+*/
+func (model *Model) GetCharAttrVars(attrname string, vars []*Var) ([]int8, error) {
+	ind, err := varIndices(vars)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetCharAttrList(attrname, ind)
+}
+
+/*
+SetCharAttrVars
+Description:
+
+	Sets the char attribute attrname of each of the given vars.
+*/
+func (model *Model) SetCharAttrVars(attrname string, vars []*Var, values []int8) error {
+	ind, err := varIndices(vars)
+	if err != nil {
+		return err
+	}
+	return model.SetCharAttrList(attrname, ind, values)
+}
+
+/*
+GetCharAttrConstrs
+Description:
+
+	Returns the value of the char attribute attrname for each of the given constrs.
+*/
+func (model *Model) GetCharAttrConstrs(attrname string, constrs []*Constr) ([]int8, error) {
+	ind, err := constrIndices(constrs)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetCharAttrList(attrname, ind)
+}
+
+/*
+SetCharAttrConstrs
+Description:
+
+	Sets the char attribute attrname of each of the given constrs.
+*/
+func (model *Model) SetCharAttrConstrs(attrname string, constrs []*Constr, values []int8) error {
+	ind, err := constrIndices(constrs)
+	if err != nil {
+		return err
+	}
+	return model.SetCharAttrList(attrname, ind, values)
+}
+
+/*
+GetDoubleAttrVars
+Description:
+
+	Returns the value of the double attribute attrname for each of the given vars.
+*/
+func (model *Model) GetDoubleAttrVars(attrname string, vars []*Var) ([]float64, error) {
+	ind, err := varIndices(vars)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetDoubleAttrList(attrname, ind)
+}
+
+/*
+SetDoubleAttrVars
+Description:
+
+	Sets the double attribute attrname of each of the given vars.
+*/
+func (model *Model) SetDoubleAttrVars(attrname string, vars []*Var, values []float64) error {
+	ind, err := varIndices(vars)
+	if err != nil {
+		return err
+	}
+	return model.SetDoubleAttrList(attrname, ind, values)
+}
+
+/*
+GetDoubleAttrConstrs
+Description:
+
+	Returns the value of the double attribute attrname for each of the given constrs.
+*/
+func (model *Model) GetDoubleAttrConstrs(attrname string, constrs []*Constr) ([]float64, error) {
+	ind, err := constrIndices(constrs)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetDoubleAttrList(attrname, ind)
+}
+
+/*
+SetDoubleAttrConstrs
+Description:
+
+	Sets the double attribute attrname of each of the given constrs.
+*/
+func (model *Model) SetDoubleAttrConstrs(attrname string, constrs []*Constr, values []float64) error {
+	ind, err := constrIndices(constrs)
+	if err != nil {
+		return err
+	}
+	return model.SetDoubleAttrList(attrname, ind, values)
+}
+
+/*
+GetStringAttrVars
+Description:
+
+	Returns the value of the string attribute attrname for each of the given vars.
+*/
+func (model *Model) GetStringAttrVars(attrname string, vars []*Var) ([]string, error) {
+	ind, err := varIndices(vars)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetStringAttrList(attrname, ind)
+}
+
+/*
+SetStringAttrVars
+Description:
+
+	Sets the string attribute attrname of each of the given vars.
+*/
+func (model *Model) SetStringAttrVars(attrname string, vars []*Var, values []string) error {
+	ind, err := varIndices(vars)
+	if err != nil {
+		return err
+	}
+	return model.SetStringAttrList(attrname, ind, values)
+}
+
+/*
+GetStringAttrConstrs
+Description:
+
+	Returns the value of the string attribute attrname for each of the given constrs.
+*/
+func (model *Model) GetStringAttrConstrs(attrname string, constrs []*Constr) ([]string, error) {
+	ind, err := constrIndices(constrs)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetStringAttrList(attrname, ind)
+}
+
+/*
+SetStringAttrConstrs
+Description:
+
+	Sets the string attribute attrname of each of the given constrs.
+*/
+func (model *Model) SetStringAttrConstrs(attrname string, constrs []*Constr, values []string) error {
+	ind, err := constrIndices(constrs)
+	if err != nil {
+		return err
+	}
+	return model.SetStringAttrList(attrname, ind, values)
+}
+
+/*
+goStrings
+Description:
+
+	Copies the C strings returned by the C api into Go strings.
+*/
+func goStrings(cValues []*C.char) []string {
+	values := make([]string, len(cValues))
+	for i, cValue := range cValues {
+		values[i] = C.GoString(cValue)
+	}
+	return values
+}
+
+/*
+cStrings
+Description:
+
+	Converts Go strings into C strings. The result must be released with freeCStrings.
+*/
+func cStrings(values []string) []*C.char {
+	cValues := make([]*C.char, len(values))
+	for i, value := range values {
+		cValues[i] = C.CString(value)
+	}
+	return cValues
+}
+
+func freeCStrings(cValues []*C.char) {
+	for _, cValue := range cValues {
+		C.free(unsafe.Pointer(cValue))
+	}
+}
@@ -0,0 +1,500 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+	"runtime/cgo"
+	"unsafe"
+)
+
+/*
+callback.go
+Description:
+	Types, constants and accessors for the Go side of Gurobi callbacks.
+Notes:
+	The list of where and what codes is given on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/cb_codes.html
+*/
+
+// Where codes (i.e. from which part of the optimization the callback was made)
+const CB_POLLING = C.GRB_CB_POLLING
+const CB_PRESOLVE = C.GRB_CB_PRESOLVE
+const CB_SIMPLEX = C.GRB_CB_SIMPLEX
+const CB_MIP = C.GRB_CB_MIP
+const CB_MIPSOL = C.GRB_CB_MIPSOL
+const CB_MIPNODE = C.GRB_CB_MIPNODE
+const CB_MESSAGE = C.GRB_CB_MESSAGE
+const CB_BARRIER = C.GRB_CB_BARRIER
+
+// What codes (i.e. which piece of information is requested with cbget)
+const CB_RUNTIME = C.GRB_CB_RUNTIME
+
+const CB_PRE_COLDEL = C.GRB_CB_PRE_COLDEL
+const CB_PRE_ROWDEL = C.GRB_CB_PRE_ROWDEL
+const CB_PRE_SENCHG = C.GRB_CB_PRE_SENCHG
+const CB_PRE_BNDCHG = C.GRB_CB_PRE_BNDCHG
+const CB_PRE_COECHG = C.GRB_CB_PRE_COECHG
+
+const CB_SPX_ITRCNT = C.GRB_CB_SPX_ITRCNT
+const CB_SPX_OBJVAL = C.GRB_CB_SPX_OBJVAL
+const CB_SPX_PRIMINF = C.GRB_CB_SPX_PRIMINF
+const CB_SPX_DUALINF = C.GRB_CB_SPX_DUALINF
+const CB_SPX_ISPERT = C.GRB_CB_SPX_ISPERT
+
+const CB_MIP_OBJBST = C.GRB_CB_MIP_OBJBST
+const CB_MIP_OBJBND = C.GRB_CB_MIP_OBJBND
+const CB_MIP_NODCNT = C.GRB_CB_MIP_NODCNT
+const CB_MIP_SOLCNT = C.GRB_CB_MIP_SOLCNT
+const CB_MIP_CUTCNT = C.GRB_CB_MIP_CUTCNT
+const CB_MIP_NODLFT = C.GRB_CB_MIP_NODLFT
+const CB_MIP_ITRCNT = C.GRB_CB_MIP_ITRCNT
+
+const CB_MIPSOL_SOL = C.GRB_CB_MIPSOL_SOL
+const CB_MIPSOL_OBJ = C.GRB_CB_MIPSOL_OBJ
+const CB_MIPSOL_OBJBST = C.GRB_CB_MIPSOL_OBJBST
+const CB_MIPSOL_OBJBND = C.GRB_CB_MIPSOL_OBJBND
+const CB_MIPSOL_NODCNT = C.GRB_CB_MIPSOL_NODCNT
+const CB_MIPSOL_SOLCNT = C.GRB_CB_MIPSOL_SOLCNT
+
+const CB_MIPNODE_STATUS = C.GRB_CB_MIPNODE_STATUS
+const CB_MIPNODE_REL = C.GRB_CB_MIPNODE_REL
+const CB_MIPNODE_OBJBST = C.GRB_CB_MIPNODE_OBJBST
+const CB_MIPNODE_OBJBND = C.GRB_CB_MIPNODE_OBJBND
+const CB_MIPNODE_NODCNT = C.GRB_CB_MIPNODE_NODCNT
+const CB_MIPNODE_SOLCNT = C.GRB_CB_MIPNODE_SOLCNT
+
+const CB_MSG_STRING = C.GRB_CB_MSG_STRING
+
+// CallbackFunc is the signature of a Go function that can be given to Model.SetCallback.
+// Returning a non-nil error aborts the optimization; Optimize() then returns that error.
+type CallbackFunc func(ctx *CallbackContext) error
+
+// CallbackContext describes the state of the solver at the moment a callback was made.
+// It is only valid for the duration of the callback.
+type CallbackContext struct {
+	Model  *Model
+	Where  int32
+	cbdata unsafe.Pointer
+}
+
+/*
+goCallbackTrampoline
+Description:
+
+	The C function that Gurobi calls. It recovers the model from the handle given
+	as usrdata and forwards the call to the model's Go callback.
+	Any error (or panic) from the Go callback is saved on the model and a nonzero
+	value is returned so that Gurobi stops the optimization.
+*/
+//export goCallbackTrampoline
+func goCallbackTrampoline(grbModel *C.GRBmodel, cbdata unsafe.Pointer, where C.int, usrdata unsafe.Pointer) (retCode C.int) {
+	model, ok := cgo.Handle(uintptr(usrdata)).Value().(*Model)
+	if !ok || model.callback == nil {
+		return 0
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			model.callbackErr = fmt.Errorf("panic in gurobi callback: %v", r)
+			retCode = C.GRB_ERROR_CALLBACK
+		}
+	}()
+
+	ctx := CallbackContext{
+		Model:  model,
+		Where:  int32(where),
+		cbdata: cbdata,
+	}
+	if err := model.callback(&ctx); err != nil {
+		model.callbackErr = err
+		return C.GRB_ERROR_CALLBACK
+	}
+
+	return 0
+}
+
+/*
+GetInt
+Description:
+
+	Mirrors GRBcbget() for what codes which return an integer value
+	(e.g. CB_MIP_SOLCNT).
+*/
+func (ctx *CallbackContext) GetInt(what int32) (int32, error) {
+	var value C.int
+	errCode := C.GRBcbget(ctx.cbdata, C.int(ctx.Where), C.int(what), unsafe.Pointer(&value))
+	if errCode != 0 {
+		return 0, ctx.Model.MakeError(errCode)
+	}
+	return int32(value), nil
+}
+
+/*
+GetDouble
+Description:
+
+	Mirrors GRBcbget() for what codes which return a double value
+	(e.g. CB_MIP_OBJBST or CB_RUNTIME).
+*/
+func (ctx *CallbackContext) GetDouble(what int32) (float64, error) {
+	var value C.double
+	errCode := C.GRBcbget(ctx.cbdata, C.int(ctx.Where), C.int(what), unsafe.Pointer(&value))
+	if errCode != 0 {
+		return 0, ctx.Model.MakeError(errCode)
+	}
+	return float64(value), nil
+}
+
+/*
+GetDoubleArray
+Description:
+
+	Mirrors GRBcbget() for what codes which return one double value for each
+	variable in the model (e.g. CB_MIPSOL_SOL or CB_MIPNODE_REL).
+*/
+func (ctx *CallbackContext) GetDoubleArray(what int32) ([]float64, error) {
+	values := make([]float64, len(ctx.Model.Variables))
+	if len(values) == 0 {
+		return values, nil
+	}
+
+	errCode := C.GRBcbget(ctx.cbdata, C.int(ctx.Where), C.int(what), unsafe.Pointer(&values[0]))
+	if errCode != 0 {
+		return nil, ctx.Model.MakeError(errCode)
+	}
+	return values, nil
+}
+
+/*
+GetString
+Description:
+
+	Mirrors GRBcbget() for what codes which return a string (i.e. CB_MSG_STRING).
+*/
+func (ctx *CallbackContext) GetString(what int32) (string, error) {
+	var value *C.char
+	errCode := C.GRBcbget(ctx.cbdata, C.int(ctx.Where), C.int(what), unsafe.Pointer(&value))
+	if errCode != 0 {
+		return "", ctx.Model.MakeError(errCode)
+	}
+	return C.GoString(value), nil
+}
+
+/*
+Terminate
+Description:
+
+	Asks Gurobi to stop the optimization as soon as possible.
+	The model's Status will be INTERRUPTED once Optimize() returns.
+*/
+func (ctx *CallbackContext) Terminate() {
+	C.GRBterminate(ctx.Model.AsGRBModel)
+}
+
+/*
+Runtime
+Description:
+
+	Elapsed solver runtime (in seconds). Available from every where code.
+*/
+func (ctx *CallbackContext) Runtime() (float64, error) {
+	return ctx.GetDouble(CB_RUNTIME)
+}
+
+/*
+Message
+Description:
+
+	The log message which triggered a CB_MESSAGE callback.
+*/
+func (ctx *CallbackContext) Message() (string, error) {
+	return ctx.GetString(CB_MSG_STRING)
+}
+
+/*
+IncumbentObjective
+Description:
+
+	Objective value of the best known solution.
+	Available when Where is CB_MIP, CB_MIPSOL or CB_MIPNODE.
+*/
+func (ctx *CallbackContext) IncumbentObjective() (float64, error) {
+	switch ctx.Where {
+	case CB_MIP:
+		return ctx.GetDouble(CB_MIP_OBJBST)
+	case CB_MIPSOL:
+		return ctx.GetDouble(CB_MIPSOL_OBJBST)
+	case CB_MIPNODE:
+		return ctx.GetDouble(CB_MIPNODE_OBJBST)
+	}
+	return 0, ctx.wrongWhereError("IncumbentObjective")
+}
+
+/*
+ObjectiveBound
+Description:
+
+	Best known bound on the optimal objective value.
+	Available when Where is CB_MIP, CB_MIPSOL or CB_MIPNODE.
+*/
+func (ctx *CallbackContext) ObjectiveBound() (float64, error) {
+	switch ctx.Where {
+	case CB_MIP:
+		return ctx.GetDouble(CB_MIP_OBJBND)
+	case CB_MIPSOL:
+		return ctx.GetDouble(CB_MIPSOL_OBJBND)
+	case CB_MIPNODE:
+		return ctx.GetDouble(CB_MIPNODE_OBJBND)
+	}
+	return 0, ctx.wrongWhereError("ObjectiveBound")
+}
+
+/*
+NodeCount
+Description:
+
+	Number of branch-and-bound nodes explored so far.
+	Available when Where is CB_MIP, CB_MIPSOL or CB_MIPNODE.
+*/
+func (ctx *CallbackContext) NodeCount() (float64, error) {
+	switch ctx.Where {
+	case CB_MIP:
+		return ctx.GetDouble(CB_MIP_NODCNT)
+	case CB_MIPSOL:
+		return ctx.GetDouble(CB_MIPSOL_NODCNT)
+	case CB_MIPNODE:
+		return ctx.GetDouble(CB_MIPNODE_NODCNT)
+	}
+	return 0, ctx.wrongWhereError("NodeCount")
+}
+
+/*
+SolutionCount
+Description:
+
+	Number of feasible solutions found so far.
+	Available when Where is CB_MIP, CB_MIPSOL or CB_MIPNODE.
+*/
+func (ctx *CallbackContext) SolutionCount() (int32, error) {
+	switch ctx.Where {
+	case CB_MIP:
+		return ctx.GetInt(CB_MIP_SOLCNT)
+	case CB_MIPSOL:
+		return ctx.GetInt(CB_MIPSOL_SOLCNT)
+	case CB_MIPNODE:
+		return ctx.GetInt(CB_MIPNODE_SOLCNT)
+	}
+	return 0, ctx.wrongWhereError("SolutionCount")
+}
+
+/*
+MIPSolObjective
+Description:
+
+	Objective value of the new solution which triggered a CB_MIPSOL callback.
+*/
+func (ctx *CallbackContext) MIPSolObjective() (float64, error) {
+	return ctx.GetDouble(CB_MIPSOL_OBJ)
+}
+
+/*
+MIPSolValues
+Description:
+
+	Values of the given variables in the new solution which triggered a
+	CB_MIPSOL callback.
+*/
+func (ctx *CallbackContext) MIPSolValues(vars []*Var) ([]float64, error) {
+	sol, err := ctx.GetDoubleArray(CB_MIPSOL_SOL)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.pickValues(sol, vars)
+}
+
+/*
+MIPNodeRelaxationValues
+Description:
+
+	Values of the given variables in the node relaxation solution of a CB_MIPNODE callback.
+	Only available when the node status (CB_MIPNODE_STATUS) is OPTIMAL.
+*/
+func (ctx *CallbackContext) MIPNodeRelaxationValues(vars []*Var) ([]float64, error) {
+	rel, err := ctx.GetDoubleArray(CB_MIPNODE_REL)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.pickValues(rel, vars)
+}
+
+/*
+pickValues
+Description:
+
+	Selects the entries of a full solution vector which correspond to vars.
+*/
+func (ctx *CallbackContext) pickValues(all []float64, vars []*Var) ([]float64, error) {
+	values := make([]float64, len(vars))
+	for i, v := range vars {
+		if v.Index < 0 || int(v.Index) >= len(all) {
+			return nil, fmt.Errorf("variable index %v is not in the callback's model (%v variables)", v.Index, len(all))
+		}
+		values[i] = all[v.Index]
+	}
+	return values, nil
+}
+
+func (ctx *CallbackContext) wrongWhereError(method string) error {
+	return fmt.Errorf("%v() is not available from a callback with where = %v", method, ctx.Where)
+}
+
+/*
+AddLazy
+Description:
+
+	Adds a lazy constraint from a CB_MIPSOL or CB_MIPNODE callback.
+	Uses the GRBcblazy() method from the C api.
+	The LazyConstraints parameter of the model must be set to 1 before
+	optimizing for Gurobi to accept lazy constraints.
+
+Inputs:
+  - vars: The variables which appear in the lazy constraint.
+  - vals: The coefficients of each variable in vars.
+  - sense: A flag which determines if this is an equality, less than equal or greater than or equal constraint.
+  - rhs: The constant on the right hand side of the constraint.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_cblazy.html
+*/
+func (ctx *CallbackContext) AddLazy(vars []*Var, vals []float64, sense int8, rhs float64) error {
+	ind, err := ctx.callbackRowIndices(vars, vals)
+	if err != nil {
+		return err
+	}
+
+	pind := (*C.int)(nil)
+	pval := (*C.double)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+		pval = (*C.double)(&vals[0])
+	}
+
+	errCode := C.GRBcblazy(ctx.cbdata, C.int(len(ind)), pind, pval, C.char(sense), C.double(rhs))
+	if errCode != 0 {
+		return ctx.Model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+AddCut
+Description:
+
+	Adds a user cut from a CB_MIPNODE callback.
+	Uses the GRBcbcut() method from the C api.
+	Cuts may only cut off fractional solutions; they must not remove any
+	integer feasible solution. Use AddLazy for that.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_cbcut.html
+*/
+func (ctx *CallbackContext) AddCut(vars []*Var, vals []float64, sense int8, rhs float64) error {
+	ind, err := ctx.callbackRowIndices(vars, vals)
+	if err != nil {
+		return err
+	}
+
+	pind := (*C.int)(nil)
+	pval := (*C.double)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+		pval = (*C.double)(&vals[0])
+	}
+
+	errCode := C.GRBcbcut(ctx.cbdata, C.int(len(ind)), pind, pval, C.char(sense), C.double(rhs))
+	if errCode != 0 {
+		return ctx.Model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+SetSolution
+Description:
+
+	Gives Gurobi a (possibly partial) heuristic solution from a CB_MIPNODE callback.
+	Variables that are not in vars are left undefined so that Gurobi can try to fill them in.
+	Uses the GRBcbsolution() method from the C api.
+
+Output:
+
+	The objective value of the resulting solution, or INFINITY (MINIMIZE) / -INFINITY (MAXIMIZE)
+	if Gurobi could not turn it into a feasible solution.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_cbsolution.html
+*/
+func (ctx *CallbackContext) SetSolution(vars []*Var, vals []float64) (float64, error) {
+	if len(vars) != len(vals) {
+		return 0, MismatchedLengthError{
+			Length1: len(vars),
+			Length2: len(vals),
+			Name1:   "vars",
+			Name2:   "vals",
+		}
+	}
+
+	solution := make([]float64, len(ctx.Model.Variables))
+	for i := range solution {
+		solution[i] = UNDEFINED
+	}
+	for i, v := range vars {
+		if v.Index < 0 || int(v.Index) >= len(solution) {
+			return 0, fmt.Errorf("variable index %v is not in the callback's model (%v variables)", v.Index, len(solution))
+		}
+		solution[v.Index] = vals[i]
+	}
+
+	psolution := (*C.double)(nil)
+	if len(solution) > 0 {
+		psolution = (*C.double)(&solution[0])
+	}
+
+	var objVal C.double
+	errCode := C.GRBcbsolution(ctx.cbdata, psolution, &objVal)
+	if errCode != 0 {
+		return 0, ctx.Model.MakeError(errCode)
+	}
+	return float64(objVal), nil
+}
+
+/*
+callbackRowIndices
+Description:
+
+	Checks the inputs for a lazy constraint or cut and converts the variables into
+	their indices in the Gurobi model.
+*/
+func (ctx *CallbackContext) callbackRowIndices(vars []*Var, vals []float64) ([]int32, error) {
+	if len(vars) != len(vals) {
+		return nil, MismatchedLengthError{
+			Length1: len(vars),
+			Length2: len(vals),
+			Name1:   "vars",
+			Name2:   "vals",
+		}
+	}
+
+	ind := make([]int32, len(vars))
+	for i, v := range vars {
+		if v.Index < 0 {
+			return nil, fmt.Errorf("invalid index (%v) for variable in vars", v.Index)
+		}
+		ind[i] = v.Index
+	}
+	return ind, nil
+}
@@ -0,0 +1,84 @@
+package gurobi
+
+/*
+#include <gurobi_passthrough.h>
+#include <stdint.h>
+
+int goCallbackTrampoline(GRBmodel *model, void *cbdata, int where, void *usrdata);
+
+static int setGoCallback(GRBmodel *model, uintptr_t handle) {
+	return GRBsetcallbackfunc(model, goCallbackTrampoline, (void *) handle);
+}
+
+static int clearGoCallback(GRBmodel *model) {
+	return GRBsetcallbackfunc(model, NULL, NULL);
+}
+*/
+import "C"
+import (
+	"runtime/cgo"
+)
+
+/*
+callback_bridge.go
+Description:
+	Connects Gurobi's C callback mechanism (GRBsetcallbackfunc) to Go.
+	The C helpers live in this file because cgo does not allow definitions
+	in the preamble of a file that uses //export (see callback.go).
+*/
+
+/*
+SetCallback
+Description:
+
+	Registers a Go function that Gurobi will call periodically during Optimize().
+	The model is stored in a cgo.Handle which is passed to Gurobi as the user data
+	pointer and resolved again inside of the trampoline.
+	Passing nil removes any callback that was previously registered.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_setcallbackfunc.html
+*/
+func (model *Model) SetCallback(cb CallbackFunc) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	// Remove the old callback before installing a new one.
+	if errCode := C.clearGoCallback(model.AsGRBModel); errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	model.releaseCallback()
+
+	if cb == nil {
+		return nil
+	}
+
+	model.callback = cb
+	model.callbackHandle = cgo.NewHandle(model)
+
+	errCode := C.setGoCallback(model.AsGRBModel, C.uintptr_t(model.callbackHandle))
+	if errCode != 0 {
+		model.releaseCallback()
+		return model.MakeError(errCode)
+	}
+
+	return nil
+}
+
+/*
+releaseCallback
+Description:
+
+	Frees the handle held for the model's callback (if one exists).
+*/
+func (model *Model) releaseCallback() {
+	if model.callbackHandle != 0 {
+		model.callbackHandle.Delete()
+		model.callbackHandle = 0
+	}
+	model.callback = nil
+	model.callbackErr = nil
+}
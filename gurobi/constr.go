@@ -1,11 +1,176 @@
 package gurobi
 
+// #include <gurobi_passthrough.h>
+import "C"
+import "fmt"
+
+/*
+constr.go
+Description:
+	A set of functions for manipulating the gurobi Constr object.
+Notes:
+	The available attributes for linear constraints are listed on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/linear_constraint_attribu.html
+*/
+
+// Basis statuses (values of the VBasis and CBasis attributes)
+const BASIC = C.GRB_BASIC
+const NONBASIC_LOWER = C.GRB_NONBASIC_LOWER
+const NONBASIC_UPPER = C.GRB_NONBASIC_UPPER
+const SUPERBASIC = C.GRB_SUPERBASIC
+
 // Gurobi linear constraint object
 type Constr struct {
 	Model *Model
 	Index int32
 }
 
+/*
+appendConstr
+Description:
+
+	Creates the handle for the linear constraint which was just added to the Gurobi model.
+	Like appendVar, each handle is allocated separately so that it survives growth of model.Constraints.
+*/
+func (model *Model) appendConstr() *Constr {
+	c := &Constr{model, int32(len(model.Constraints))}
+	model.Constraints = append(model.Constraints, c)
+	return c
+}
+
+/*
+ownsConstr
+Description:
+
+	Returns true if c is the current handle of one of the model's linear constraints.
+*/
+func (model *Model) ownsConstr(c *Constr) bool {
+	return c != nil && c.Index >= 0 && int(c.Index) < len(model.Constraints) && model.Constraints[c.Index] == c
+}
+
+/*
+constrIndices
+Description:
+
+	Collects the Gurobi index of each constraint in constrs.
+	Returns an error if any of the constraints has an invalid index.
+*/
+func constrIndices(constrs []*Constr) ([]int32, error) {
+	ind := make([]int32, len(constrs))
+	for i, c := range constrs {
+		if c == nil || c.Index < 0 {
+			return nil, fmt.Errorf("invalid constraint given at position %v", i)
+		}
+		ind[i] = c.Index
+	}
+	return ind, nil
+}
+
+func (c *Constr) GetInt(attr string) (int32, error) {
+	return c.Model.getIntAttrElement(attr, c.Index)
+}
+
+func (c *Constr) GetChar(attr string) (int8, error) {
+	return c.Model.getCharAttrElement(attr, c.Index)
+}
+
+func (c *Constr) GetDouble(attr string) (float64, error) {
+	return c.Model.getDoubleAttrElement(attr, c.Index)
+}
+
+func (c *Constr) GetString(attr string) (string, error) {
+	return c.Model.getStringAttrElement(attr, c.Index)
+}
+
+func (c *Constr) SetInt(attr string, value int32) error {
+	return c.Model.setIntAttrElement(attr, c.Index, value)
+}
+
+func (c *Constr) SetChar(attr string, value int8) error {
+	return c.Model.setCharAttrElement(attr, c.Index, value)
+}
+
+func (c *Constr) SetDouble(attr string, value float64) error {
+	return c.Model.setDoubleAttrElement(attr, c.Index, value)
+}
+
+func (c *Constr) SetString(attr string, value string) error {
+	return c.Model.setStringAttrElement(attr, c.Index, value)
+}
+
+/*
+RHS
+Description:
+
+	Right hand side of the linear constraint.
+*/
+func (c *Constr) RHS() (float64, error) {
+	return c.GetDouble("RHS")
+}
+
+/*
+Sense
+Description:
+
+	Sense of the linear constraint (SenseLessThan, SenseGreaterThan or SenseEqual).
+*/
+func (c *Constr) Sense() (int8, error) {
+	return c.GetChar("Sense")
+}
+
+/*
+Slack
+Description:
+
+	Slack of the linear constraint in the current solution.
+*/
+func (c *Constr) Slack() (float64, error) {
+	return c.GetDouble("Slack")
+}
+
+/*
+Pi
+Description:
+
+	Dual value (shadow price) of the linear constraint in the current solution.
+	Only available for continuous models.
+*/
+func (c *Constr) Pi() (float64, error) {
+	return c.GetDouble("Pi")
+}
+
+/*
+CBasis
+Description:
+
+	Status of the linear constraint in the current basis
+	(BASIC, NONBASIC_LOWER, NONBASIC_UPPER or SUPERBASIC).
+*/
+func (c *Constr) CBasis() (int32, error) {
+	return c.GetInt("CBasis")
+}
+
+/*
+ConstrName
+Description:
+
+	Name of the linear constraint.
+*/
+func (c *Constr) ConstrName() (string, error) {
+	return c.GetString("ConstrName")
+}
+
+/*
+Lazy
+Description:
+
+	Lazy flag of the linear constraint. 0 means the constraint is a normal constraint;
+	1, 2 and 3 make it a lazy constraint with increasing effort to enforce it early.
+*/
+func (c *Constr) Lazy() (int32, error) {
+	return c.GetInt("Lazy")
+}
+
 /*
 VectorConstraintToGurobiSparseFormat
 Description:
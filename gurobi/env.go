@@ -2,10 +2,7 @@ package gurobi
 
 // #include <gurobi_passthrough.h>
 import "C"
-import (
-	"errors"
-	"fmt"
-)
+import "unsafe"
 
 type Env struct {
 	env *C.GRBenv
@@ -14,9 +11,16 @@ type Env struct {
 // create a new environment.
 func NewEnv(logfilename string) (*Env, error) {
 	var env *C.GRBenv = nil
-	errcode := int(C.GRBloadenv(&env, C.CString(logfilename)))
+	errcode := C.GRBloadenv(&env, C.CString(logfilename))
 	if errcode != 0 {
-		return nil, errors.New("Cannot create environment.")
+		// Gurobi still creates the env on failure so that the error message (e.g. the
+		// reason that no license was found) can be retrieved from it.
+		message := "Cannot create environment."
+		if env != nil {
+			message = C.GoString(C.GRBgeterrormsg(env))
+			C.GRBfreeenv(env)
+		}
+		return nil, Error{int32(errcode), message}
 	}
 
 	return &Env{env}, nil
@@ -47,9 +51,9 @@ func (env *Env) SetTimeLimit(limitIn float64) error {
 	}
 
 	// Algorithm
-	errcode := int(C.GRBsetdblparam(env.env, C.CString(paramName), C.double(limitIn)))
+	errcode := C.GRBsetdblparam(env.env, C.CString(paramName), C.double(limitIn))
 	if errcode != 0 {
-		return fmt.Errorf("There was an error running GRBsetdblparam(): Error code %v", errcode)
+		return env.MakeError(errcode)
 	}
 
 	// If everything was successful, then return nil.
@@ -76,9 +80,9 @@ func (env *Env) GetTimeLimit() (float64, error) {
 
 	// Algorithm
 	var limitOut C.double
-	errcode := int(C.GRBgetdblparam(env.env, C.CString(paramName), &limitOut))
+	errcode := C.GRBgetdblparam(env.env, C.CString(paramName), &limitOut)
 	if errcode != 0 {
-		return -1, fmt.Errorf("There was an error running GRBsetdblparam(): Error code %v", errcode)
+		return -1, env.MakeError(errcode)
 	}
 
 	// If everything was successful, then return nil.
@@ -90,24 +94,24 @@ func (env *Env) GetTimeLimit() (float64, error) {
 SetDBLParam()
 Description:
 
-	Mirrors the functionality of the GRBsetdblattr() function from the C api.
+	Mirrors the functionality of the GRBsetdblparam() function from the C api.
 	Sets the parameter of the solver that has name paramName with value val.
 */
 func (env *Env) SetDBLParam(paramName string, val float64) error {
-	// Check that attribute is actually a scalar double attribute.
-	if !IsValidDBLParam(paramName) {
-		return fmt.Errorf("The input attribute name (%v) is not considered a valid attribute.", paramName)
+	// Check that the env object is not nil.
+	if err := env.Check(); err != nil {
+		return env.MakeUninitializedError()
 	}
 
-	// Check that the env object is not nil.
-	if env == nil {
-		return fmt.Errorf("The input env variable to SetTimeLimit() was nil!")
+	// Check that the parameter is actually a double parameter.
+	if err := env.checkParamType(paramName, PARAM_TYPE_DOUBLE); err != nil {
+		return err
 	}
 
-	// Set Attribute
-	errcode := int(C.GRBsetdblparam(env.env, C.CString(paramName), C.double(val)))
+	// Set Parameter
+	errcode := C.GRBsetdblparam(env.env, C.CString(paramName), C.double(val))
 	if errcode != 0 {
-		return fmt.Errorf("There was an error running GRBsetdblparam(), errcode %v", errcode)
+		return env.MakeError(errcode)
 	}
 
 	// If everything was successful, then return nil.
@@ -119,31 +123,195 @@ func (env *Env) SetDBLParam(paramName string, val float64) error {
 GetDBLParam()
 Description:
 
-	Mirrors the functionality of the GRBgetdblattr() function from the C api.
+	Mirrors the functionality of the GRBgetdblparam() function from the C api.
 	Gets the parameter of the model with the name paramName if it exists.
 */
 func (env *Env) GetDBLParam(paramName string) (float64, error) {
-	// Check the paramName to make sure it is valid
-	if !IsValidDBLParam(paramName) {
-		return -1, fmt.Errorf("The input attribute name (%v) is not considered a valid attribute.", paramName)
+	// Check environment input
+	if err := env.Check(); err != nil {
+		return -1, env.MakeUninitializedError()
 	}
 
-	// Check environment input
-	if env == nil {
-		return -1, fmt.Errorf("The input env variable to SetTimeLimit() was nil!")
+	// Check the paramName to make sure it is valid
+	if err := env.checkParamType(paramName, PARAM_TYPE_DOUBLE); err != nil {
+		return -1, err
 	}
 
 	// Use GRBgetdblparam
 	var valOut C.double
-	errcode := int(C.GRBgetdblparam(env.env, C.CString(paramName), &valOut))
+	errcode := C.GRBgetdblparam(env.env, C.CString(paramName), &valOut)
 	if errcode != 0 {
-		return -1, fmt.Errorf("There was an error running GRBgetdblparam(). Errorcode %v", errcode)
+		return -1, env.MakeError(errcode)
 	}
 
 	// If everything was successful, then return nil.
 	return float64(valOut), nil
 }
 
+/*
+SetIntParam()
+Description:
+
+	Mirrors the functionality of the GRBsetintparam() function from the C api.
+	Sets the integer parameter of the solver that has name paramName with value val.
+*/
+func (env *Env) SetIntParam(paramName string, val int32) error {
+	// Check that the env object is not nil.
+	if err := env.Check(); err != nil {
+		return env.MakeUninitializedError()
+	}
+
+	// Check that the parameter is actually an integer parameter.
+	if err := env.checkParamType(paramName, PARAM_TYPE_INT); err != nil {
+		return err
+	}
+
+	// Set Parameter
+	errcode := C.GRBsetintparam(env.env, C.CString(paramName), C.int(val))
+	if errcode != 0 {
+		return env.MakeError(errcode)
+	}
+
+	// If everything was successful, then return nil.
+	return nil
+}
+
+/*
+GetIntParam()
+Description:
+
+	Mirrors the functionality of the GRBgetintparam() function from the C api.
+	Gets the integer parameter of the solver with the name paramName.
+*/
+func (env *Env) GetIntParam(paramName string) (int32, error) {
+	// Check environment input
+	if err := env.Check(); err != nil {
+		return -1, env.MakeUninitializedError()
+	}
+
+	// Check the paramName to make sure it is valid
+	if err := env.checkParamType(paramName, PARAM_TYPE_INT); err != nil {
+		return -1, err
+	}
+
+	// Use GRBgetintparam
+	var valOut C.int
+	errcode := C.GRBgetintparam(env.env, C.CString(paramName), &valOut)
+	if errcode != 0 {
+		return -1, env.MakeError(errcode)
+	}
+
+	return int32(valOut), nil
+}
+
+/*
+SetStrParam()
+Description:
+
+	Mirrors the functionality of the GRBsetstrparam() function from the C api.
+	Sets the string parameter of the solver that has name paramName with value val.
+*/
+func (env *Env) SetStrParam(paramName string, val string) error {
+	// Check that the env object is not nil.
+	if err := env.Check(); err != nil {
+		return env.MakeUninitializedError()
+	}
+
+	// Check that the parameter is actually a string parameter.
+	if err := env.checkParamType(paramName, PARAM_TYPE_STRING); err != nil {
+		return err
+	}
+
+	// Set Parameter
+	errcode := C.GRBsetstrparam(env.env, C.CString(paramName), C.CString(val))
+	if errcode != 0 {
+		return env.MakeError(errcode)
+	}
+
+	return nil
+}
+
+/*
+GetStrParam()
+Description:
+
+	Mirrors the functionality of the GRBgetstrparam() function from the C api.
+	Gets the string parameter of the solver with the name paramName.
+*/
+func (env *Env) GetStrParam(paramName string) (string, error) {
+	// Check environment input
+	if err := env.Check(); err != nil {
+		return "", env.MakeUninitializedError()
+	}
+
+	// Check the paramName to make sure it is valid
+	if err := env.checkParamType(paramName, PARAM_TYPE_STRING); err != nil {
+		return "", err
+	}
+
+	// Use GRBgetstrparam. The C api writes at most GRB_MAX_STRLEN characters into the buffer.
+	valOut := make([]byte, MAX_STRLEN)
+	errcode := C.GRBgetstrparam(env.env, C.CString(paramName), (*C.char)(unsafe.Pointer(&valOut[0])))
+	if errcode != 0 {
+		return "", env.MakeError(errcode)
+	}
+
+	return C.GoString((*C.char)(unsafe.Pointer(&valOut[0]))), nil
+}
+
+/*
+SetParam()
+Description:
+
+	Mirrors the functionality of the GRBsetparam() function from the C api.
+	Sets a parameter of any type from its string representation
+	(e.g. SetParam("Threads", "4") or SetParam("TimeLimit", "1e2")).
+	The value is parsed and validated by Gurobi.
+*/
+func (env *Env) SetParam(paramName string, val string) error {
+	// Check that the env object is not nil.
+	if err := env.Check(); err != nil {
+		return env.MakeUninitializedError()
+	}
+
+	// Set Parameter
+	errcode := C.GRBsetparam(env.env, C.CString(paramName), C.CString(val))
+	if errcode != 0 {
+		return env.MakeError(errcode)
+	}
+
+	return nil
+}
+
+/*
+IsValidIntParam()
+Description:
+
+	Returns true if Gurobi knows an integer parameter with the name paramName.
+*/
+func (env *Env) IsValidIntParam(paramName string) bool {
+	return env.checkParamType(paramName, PARAM_TYPE_INT) == nil
+}
+
+/*
+IsValidDBLParam()
+Description:
+
+	Returns true if Gurobi knows a double parameter with the name paramName.
+*/
+func (env *Env) IsValidDBLParam(paramName string) bool {
+	return env.checkParamType(paramName, PARAM_TYPE_DOUBLE) == nil
+}
+
+/*
+IsValidDBLParam()
+Description:
+
+	Returns true if paramName is one of the double parameters (TimeLimit, Cutoff or BestObjStop)
+	supported before the parameter types were looked up in the Gurobi library.
+
+Deprecated: Use Env.IsValidDBLParam, which accepts every double parameter known to Gurobi.
+*/
 func IsValidDBLParam(paramName string) bool {
 	// All param names
 	var scalarDoubleAttributes []string = []string{"TimeLimit", "Cutoff", "BestObjStop"}
@@ -161,6 +329,16 @@ func IsValidDBLParam(paramName string) bool {
 	return paramNameIsValid
 }
 
+/*
+IsValidStrParam()
+Description:
+
+	Returns true if Gurobi knows a string parameter with the name paramName.
+*/
+func (env *Env) IsValidStrParam(paramName string) bool {
+	return env.checkParamType(paramName, PARAM_TYPE_STRING) == nil
+}
+
 /*
 Check
 Description:
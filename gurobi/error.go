@@ -17,6 +17,78 @@ type Error struct {
 	Message   string
 }
 
+// Gurobi error codes (see https://www.gurobi.com/documentation/current/refman/error_codes.html)
+const ERROR_OUT_OF_MEMORY = C.GRB_ERROR_OUT_OF_MEMORY
+const ERROR_NULL_ARGUMENT = C.GRB_ERROR_NULL_ARGUMENT
+const ERROR_INVALID_ARGUMENT = C.GRB_ERROR_INVALID_ARGUMENT
+const ERROR_UNKNOWN_ATTRIBUTE = C.GRB_ERROR_UNKNOWN_ATTRIBUTE
+const ERROR_DATA_NOT_AVAILABLE = C.GRB_ERROR_DATA_NOT_AVAILABLE
+const ERROR_INDEX_OUT_OF_RANGE = C.GRB_ERROR_INDEX_OUT_OF_RANGE
+const ERROR_UNKNOWN_PARAMETER = C.GRB_ERROR_UNKNOWN_PARAMETER
+const ERROR_VALUE_OUT_OF_RANGE = C.GRB_ERROR_VALUE_OUT_OF_RANGE
+const ERROR_NO_LICENSE = C.GRB_ERROR_NO_LICENSE
+const ERROR_SIZE_LIMIT_EXCEEDED = C.GRB_ERROR_SIZE_LIMIT_EXCEEDED
+const ERROR_CALLBACK = C.GRB_ERROR_CALLBACK
+const ERROR_FILE_READ = C.GRB_ERROR_FILE_READ
+const ERROR_FILE_WRITE = C.GRB_ERROR_FILE_WRITE
+const ERROR_NUMERIC = C.GRB_ERROR_NUMERIC
+const ERROR_IIS_NOT_INFEASIBLE = C.GRB_ERROR_IIS_NOT_INFEASIBLE
+const ERROR_NOT_FOR_MIP = C.GRB_ERROR_NOT_FOR_MIP
+const ERROR_OPTIMIZATION_IN_PROGRESS = C.GRB_ERROR_OPTIMIZATION_IN_PROGRESS
+const ERROR_DUPLICATES = C.GRB_ERROR_DUPLICATES
+const ERROR_NODEFILE = C.GRB_ERROR_NODEFILE
+const ERROR_Q_NOT_PSD = C.GRB_ERROR_Q_NOT_PSD
+const ERROR_QCP_EQUALITY_CONSTRAINT = C.GRB_ERROR_QCP_EQUALITY_CONSTRAINT
+const ERROR_NETWORK = C.GRB_ERROR_NETWORK
+const ERROR_JOB_REJECTED = C.GRB_ERROR_JOB_REJECTED
+const ERROR_NOT_SUPPORTED = C.GRB_ERROR_NOT_SUPPORTED
+const ERROR_EXCEED_2B_NONZEROS = C.GRB_ERROR_EXCEED_2B_NONZEROS
+const ERROR_INVALID_PIECEWISE_OBJ = C.GRB_ERROR_INVALID_PIECEWISE_OBJ
+const ERROR_UPDATEMODE_CHANGE = C.GRB_ERROR_UPDATEMODE_CHANGE
+const ERROR_CLOUD = C.GRB_ERROR_CLOUD
+const ERROR_MODEL_MODIFICATION = C.GRB_ERROR_MODEL_MODIFICATION
+
+/*
+Sentinel errors
+Description:
+
+	One value for each Gurobi error code. An Error matches the sentinel with the same
+	ErrorCode under errors.Is, even after it has been wrapped with %w, e.g.
+
+		if errors.Is(err, gurobi.ErrNoLicense) { ... }
+*/
+var (
+	ErrOutOfMemory            = Error{ERROR_OUT_OF_MEMORY, "out of memory"}
+	ErrNullArgument           = Error{ERROR_NULL_ARGUMENT, "null argument"}
+	ErrInvalidArgument        = Error{ERROR_INVALID_ARGUMENT, "invalid argument"}
+	ErrUnknownAttribute       = Error{ERROR_UNKNOWN_ATTRIBUTE, "unknown attribute"}
+	ErrDataNotAvailable       = Error{ERROR_DATA_NOT_AVAILABLE, "data not available"}
+	ErrIndexOutOfRange        = Error{ERROR_INDEX_OUT_OF_RANGE, "index out of range"}
+	ErrUnknownParameter       = Error{ERROR_UNKNOWN_PARAMETER, "unknown parameter"}
+	ErrValueOutOfRange        = Error{ERROR_VALUE_OUT_OF_RANGE, "value out of range"}
+	ErrNoLicense              = Error{ERROR_NO_LICENSE, "no license"}
+	ErrSizeLimitExceeded      = Error{ERROR_SIZE_LIMIT_EXCEEDED, "size limit exceeded"}
+	ErrCallback               = Error{ERROR_CALLBACK, "callback error"}
+	ErrFileRead               = Error{ERROR_FILE_READ, "file read error"}
+	ErrFileWrite              = Error{ERROR_FILE_WRITE, "file write error"}
+	ErrNumeric                = Error{ERROR_NUMERIC, "numerical error"}
+	ErrIISNotInfeasible       = Error{ERROR_IIS_NOT_INFEASIBLE, "model is not infeasible"}
+	ErrNotForMIP              = Error{ERROR_NOT_FOR_MIP, "not available for MIP models"}
+	ErrOptimizationInProgress = Error{ERROR_OPTIMIZATION_IN_PROGRESS, "optimization in progress"}
+	ErrDuplicates             = Error{ERROR_DUPLICATES, "duplicates"}
+	ErrNodefile               = Error{ERROR_NODEFILE, "node file error"}
+	ErrQNotPSD                = Error{ERROR_Q_NOT_PSD, "Q matrix is not positive semi-definite"}
+	ErrQCPEqualityConstraint  = Error{ERROR_QCP_EQUALITY_CONSTRAINT, "quadratic equality constraint"}
+	ErrNetwork                = Error{ERROR_NETWORK, "network error"}
+	ErrJobRejected            = Error{ERROR_JOB_REJECTED, "job rejected"}
+	ErrNotSupported           = Error{ERROR_NOT_SUPPORTED, "not supported"}
+	ErrExceed2BNonzeros       = Error{ERROR_EXCEED_2B_NONZEROS, "more than 2 billion nonzeros"}
+	ErrInvalidPiecewiseObj    = Error{ERROR_INVALID_PIECEWISE_OBJ, "invalid piecewise-linear objective"}
+	ErrUpdateModeChange       = Error{ERROR_UPDATEMODE_CHANGE, "UpdateMode changed"}
+	ErrCloud                  = Error{ERROR_CLOUD, "cloud error"}
+	ErrModelModification      = Error{ERROR_MODEL_MODIFICATION, "model modification error"}
+)
+
 type MismatchedLengthError struct {
 	Length1 int
 	Length2 int
@@ -32,6 +104,18 @@ func (err Error) Error() string {
 	return err.Message
 }
 
+/*
+Is
+Description:
+
+	Reports whether target is an Error with the same error code.
+	This lets errors.Is match an Error from the C api against the sentinel errors (e.g. ErrNoLicense).
+*/
+func (err Error) Is(target error) bool {
+	t, ok := target.(Error)
+	return ok && t.ErrorCode == err.ErrorCode
+}
+
 func (err MismatchedLengthError) Error() string {
 	// Assemble string
 	return fmt.Sprintf(
@@ -0,0 +1,263 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+feasrelax.go
+Description:
+	Functions for relaxing an infeasible model so that it becomes feasible with a minimal
+	violation of its bounds and constraints, and for reading back those violations.
+Notes:
+	Gurobi relaxes the model in place by adding artificial variables named
+	ArtL_<varname>, ArtU_<varname>, ArtP_<constrname> and ArtN_<constrname>:
+	https://www.gurobi.com/documentation/current/refman/c_feasrelax.html
+*/
+
+// Types of feasibility relaxations (the relaxType argument of FeasRelax)
+const FEASRELAX_LINEAR = C.GRB_FEASRELAX_LINEAR
+const FEASRELAX_QUADRATIC = C.GRB_FEASRELAX_QUADRATIC
+const FEASRELAX_CARDINALITY = C.GRB_FEASRELAX_CARDINALITY
+
+// Prefixes of the names of the artificial variables added by FeasRelax
+const (
+	artLowerBoundPrefix = "ArtL_"
+	artUpperBoundPrefix = "ArtU_"
+	artPositivePrefix   = "ArtP_"
+	artNegativePrefix   = "ArtN_"
+)
+
+// FeasRelaxViolations holds how much each relaxed bound and constraint is violated in the current solution.
+type FeasRelaxViolations struct {
+	LowerBounds map[*Var]float64    // Amount by which each variable is below its lower bound
+	UpperBounds map[*Var]float64    // Amount by which each variable is above its upper bound
+	Constrs     map[*Constr]float64 // Amount by which each constraint is violated
+}
+
+/*
+FeasRelax
+Description:
+
+	Modifies the model so that it stays feasible when the bounds of vars and the right hand sides of
+	constrs are violated, at a cost given by the penalties. Bounds and constraints which are not listed
+	are not relaxed. A nil lbPen, ubPen or rhsPen relaxes none of the corresponding bounds or constraints.
+	Uses the GRBfeasrelax() method from the C api.
+
+	Gurobi adds artificial variables and constraints to the model; handles for them are appended to
+	model.Variables and model.Constraints. After optimizing the relaxed model, FeasRelaxViolations()
+	reports how much each bound and constraint was violated.
+
+Inputs:
+  - relaxType: FEASRELAX_LINEAR, FEASRELAX_QUADRATIC or FEASRELAX_CARDINALITY.
+  - minRelax: If true, the relaxed model is optimized once to find the minimal violation and
+    then restricted to solutions with that violation, so that the next Optimize() minimizes
+    the original objective among the minimally-violating solutions.
+  - vars, lbPen, ubPen: The variables whose bounds may be violated and the penalty of violating
+    their lower and upper bounds.
+  - constrs, rhsPen: The constraints which may be violated and the penalty of violating them.
+
+Outputs:
+  - The objective value of the relaxation problem when minRelax is true (zero otherwise).
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_feasrelax.html
+*/
+func (model *Model) FeasRelax(relaxType int, minRelax bool, vars []*Var, lbPen, ubPen []float64, constrs []*Constr, rhsPen []float64) (float64, error) {
+	err := model.Check()
+	if err != nil {
+		return 0.0, model.MakeUninitializedError()
+	}
+
+	// Check the length of each of the slices.
+	if lbPen != nil && len(vars) != len(lbPen) {
+		return 0.0, MismatchedLengthError{
+			Length1: len(vars),
+			Length2: len(lbPen),
+			Name1:   "vars",
+			Name2:   "lbPen",
+		}
+	}
+
+	if ubPen != nil && len(vars) != len(ubPen) {
+		return 0.0, MismatchedLengthError{
+			Length1: len(vars),
+			Length2: len(ubPen),
+			Name1:   "vars",
+			Name2:   "ubPen",
+		}
+	}
+
+	if rhsPen != nil && len(constrs) != len(rhsPen) {
+		return 0.0, MismatchedLengthError{
+			Length1: len(constrs),
+			Length2: len(rhsPen),
+			Name1:   "constrs",
+			Name2:   "rhsPen",
+		}
+	}
+
+	varInd, err := varIndices(vars)
+	if err != nil {
+		return 0.0, err
+	}
+
+	constrInd, err := constrIndices(constrs)
+	if err != nil {
+		return 0.0, err
+	}
+
+	// The C api expects one penalty for every variable (or constraint) of the model;
+	// an infinite penalty means that the bound (or constraint) is not relaxed.
+	plbPen := feasRelaxPenalties(len(model.Variables), varInd, lbPen)
+	pubPen := feasRelaxPenalties(len(model.Variables), varInd, ubPen)
+	prhsPen := feasRelaxPenalties(len(model.Constraints), constrInd, rhsPen)
+
+	minRelaxAsInt := 0
+	if minRelax {
+		minRelaxAsInt = 1
+	}
+
+	var feasObj C.double
+	errCode := C.GRBfeasrelax(
+		model.AsGRBModel, C.int(relaxType), C.int(minRelaxAsInt),
+		plbPen, pubPen, prhsPen, &feasObj,
+	)
+	if errCode != 0 {
+		return 0.0, model.MakeError(errCode)
+	}
+
+	if err := model.Update(); err != nil {
+		return 0.0, err
+	}
+
+	if err := model.populateHandles(); err != nil {
+		return 0.0, err
+	}
+
+	if !minRelax {
+		return 0.0, nil
+	}
+	return float64(feasObj), nil
+}
+
+/*
+feasRelaxPenalties
+Description:
+
+	Spreads the penalties of the elements ind over an array of length n in which every
+	other element has an infinite penalty. Returns nil if penalties is nil.
+*/
+func feasRelaxPenalties(n int, ind []int32, penalties []float64) *C.double {
+	if penalties == nil || n == 0 {
+		return nil
+	}
+
+	full := make([]float64, n)
+	for i := range full {
+		full[i] = INFINITY
+	}
+	for i, idx := range ind {
+		if int(idx) < n {
+			full[idx] = penalties[i]
+		}
+	}
+	return (*C.double)(&full[0])
+}
+
+/*
+FeasRelaxViolations
+Description:
+
+	Reads the values of the artificial variables that FeasRelax() added to the model and
+	reports how much each relaxed bound and constraint is violated in the current solution.
+	The artificial variables are matched to the original ones by name, so the variables and
+	constraints of the model should have unique names.
+*/
+func (model *Model) FeasRelaxViolations() (FeasRelaxViolations, error) {
+	err := model.Check()
+	if err != nil {
+		return FeasRelaxViolations{}, model.MakeUninitializedError()
+	}
+
+	varNames, err := model.GetStringAttrArray("VarName", 0, len(model.Variables))
+	if err != nil {
+		return FeasRelaxViolations{}, err
+	}
+
+	constrNames, err := model.GetStringAttrArray("ConstrName", 0, len(model.Constraints))
+	if err != nil {
+		return FeasRelaxViolations{}, err
+	}
+
+	xs, err := model.GetDoubleAttrArray("X", 0, len(model.Variables))
+	if err != nil {
+		return FeasRelaxViolations{}, err
+	}
+
+	// Find the original variables and constraints by name
+	varsByName := make(map[string]*Var)
+	for i, name := range varNames {
+		if _, isArtificial := trimArtPrefix(name); !isArtificial {
+			varsByName[name] = model.Variables[i]
+		}
+	}
+
+	constrsByName := make(map[string]*Constr)
+	for i, name := range constrNames {
+		constrsByName[name] = model.Constraints[i]
+	}
+
+	violations := FeasRelaxViolations{
+		LowerBounds: make(map[*Var]float64),
+		UpperBounds: make(map[*Var]float64),
+		Constrs:     make(map[*Constr]float64),
+	}
+	for i, name := range varNames {
+		origName, isArtificial := trimArtPrefix(name)
+		if !isArtificial {
+			continue
+		}
+
+		if strings.HasPrefix(name, artLowerBoundPrefix) || strings.HasPrefix(name, artUpperBoundPrefix) {
+			v, tf := varsByName[origName]
+			if !tf {
+				return violations, fmt.Errorf("could not find the variable %v relaxed by %v", origName, name)
+			}
+			if strings.HasPrefix(name, artLowerBoundPrefix) {
+				violations.LowerBounds[v] += xs[i]
+			} else {
+				violations.UpperBounds[v] += xs[i]
+			}
+			continue
+		}
+
+		c, tf := constrsByName[origName]
+		if !tf {
+			return violations, fmt.Errorf("could not find the constraint %v relaxed by %v", origName, name)
+		}
+		violations.Constrs[c] += xs[i]
+	}
+
+	return violations, nil
+}
+
+/*
+trimArtPrefix
+Description:
+
+	Removes the prefix of an artificial variable added by FeasRelax from name.
+	Returns false if name is not the name of such a variable.
+*/
+func trimArtPrefix(name string) (string, bool) {
+	for _, prefix := range []string{artLowerBoundPrefix, artUpperBoundPrefix, artPositivePrefix, artNegativePrefix} {
+		if strings.HasPrefix(name, prefix) {
+			return strings.TrimPrefix(name, prefix), true
+		}
+	}
+	return name, false
+}
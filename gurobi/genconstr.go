@@ -0,0 +1,358 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+)
+
+/*
+genconstr.go
+Description:
+	A set of functions for creating and manipulating the gurobi GenConstr object.
+	General constraints let Gurobi handle logical relationships (max, min, abs, and, or, indicator)
+	directly instead of through hand-written big-M formulations.
+Notes:
+	The available attributes for general constraints are listed on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/general_constraint_attribu.html
+*/
+
+// Types of general constraints (values of the GenConstrType attribute)
+const GENCONSTR_MAX = C.GRB_GENCONSTR_MAX
+const GENCONSTR_MIN = C.GRB_GENCONSTR_MIN
+const GENCONSTR_ABS = C.GRB_GENCONSTR_ABS
+const GENCONSTR_AND = C.GRB_GENCONSTR_AND
+const GENCONSTR_OR = C.GRB_GENCONSTR_OR
+const GENCONSTR_INDICATOR = C.GRB_GENCONSTR_INDICATOR
+
+// Gurobi general constraint object
+type GenConstr struct {
+	Model *Model
+	Index int32
+}
+
+/*
+AddGenConstrMax
+Description:
+
+	Adds the constraint resvar = max(vars[0], ..., vars[n-1], constant) to the model.
+	Uses the GRBaddgenconstrMax() method from the C api.
+	Use -INFINITY as the constant if it should not take part in the maximum.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrmax.html
+*/
+func (model *Model) AddGenConstrMax(resvar *Var, vars []*Var, constant float64, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	resInd, ind, err := genConstrIndices(resvar, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	pind := (*C.int)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+	}
+
+	errCode := C.GRBaddgenconstrMax(
+		model.AsGRBModel, C.CString(name),
+		C.int(resInd), C.int(len(ind)), pind, C.double(constant),
+	)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrMin
+Description:
+
+	Adds the constraint resvar = min(vars[0], ..., vars[n-1], constant) to the model.
+	Uses the GRBaddgenconstrMin() method from the C api.
+	Use INFINITY as the constant if it should not take part in the minimum.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrmin.html
+*/
+func (model *Model) AddGenConstrMin(resvar *Var, vars []*Var, constant float64, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	resInd, ind, err := genConstrIndices(resvar, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	pind := (*C.int)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+	}
+
+	errCode := C.GRBaddgenconstrMin(
+		model.AsGRBModel, C.CString(name),
+		C.int(resInd), C.int(len(ind)), pind, C.double(constant),
+	)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrAbs
+Description:
+
+	Adds the constraint resvar = |argvar| to the model.
+	Uses the GRBaddgenconstrAbs() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrabs.html
+*/
+func (model *Model) AddGenConstrAbs(resvar *Var, argvar *Var, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	resInd, ind, err := genConstrIndices(resvar, []*Var{argvar})
+	if err != nil {
+		return nil, err
+	}
+
+	errCode := C.GRBaddgenconstrAbs(model.AsGRBModel, C.CString(name), C.int(resInd), C.int(ind[0]))
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrAnd
+Description:
+
+	Adds the constraint resvar = vars[0] AND ... AND vars[n-1] to the model.
+	All of the variables must be binary.
+	Uses the GRBaddgenconstrAnd() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrand.html
+*/
+func (model *Model) AddGenConstrAnd(resvar *Var, vars []*Var, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	resInd, ind, err := genConstrIndices(resvar, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	pind := (*C.int)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+	}
+
+	errCode := C.GRBaddgenconstrAnd(model.AsGRBModel, C.CString(name), C.int(resInd), C.int(len(ind)), pind)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrOr
+Description:
+
+	Adds the constraint resvar = vars[0] OR ... OR vars[n-1] to the model.
+	All of the variables must be binary.
+	Uses the GRBaddgenconstrOr() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstror.html
+*/
+func (model *Model) AddGenConstrOr(resvar *Var, vars []*Var, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	resInd, ind, err := genConstrIndices(resvar, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	pind := (*C.int)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+	}
+
+	errCode := C.GRBaddgenconstrOr(model.AsGRBModel, C.CString(name), C.int(resInd), C.int(len(ind)), pind)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrIndicator
+Description:
+
+	Adds the constraint (binvar == binval) => (vars * vals (sense) rhs) to the model.
+	Uses the GRBaddgenconstrIndicator() method from the C api.
+
+Inputs:
+  - binvar: The binary variable which triggers the linear constraint.
+  - binval: The value of binvar (true = 1, false = 0) which triggers the linear constraint.
+  - vars: The variables which appear in the linear constraint.
+  - vals: The coefficients of each variable in vars.
+  - sense: A flag which determines if this is an equality, less than equal or greater than or equal constraint.
+  - rhs: The constant on the right hand side of the linear constraint.
+  - name: An optional name for the constraint.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrindicator.html
+*/
+func (model *Model) AddGenConstrIndicator(binvar *Var, binval bool, vars []*Var, vals []float64, sense int8, rhs float64, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	if len(vars) != len(vals) {
+		return nil, MismatchedLengthError{
+			Length1: len(vars),
+			Length2: len(vals),
+			Name1:   "vars",
+			Name2:   "vals",
+		}
+	}
+
+	binInd, ind, err := genConstrIndices(binvar, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	binvalAsInt := 0
+	if binval {
+		binvalAsInt = 1
+	}
+
+	pind := (*C.int)(nil)
+	pval := (*C.double)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+		pval = (*C.double)(&vals[0])
+	}
+
+	errCode := C.GRBaddgenconstrIndicator(
+		model.AsGRBModel, C.CString(name),
+		C.int(binInd), C.int(binvalAsInt),
+		C.int(len(ind)), pind, pval,
+		C.char(sense), C.double(rhs),
+	)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+genConstrIndices
+Description:
+
+	Checks the variables given to one of the AddGenConstr* methods and
+	collects their indices in the Gurobi model.
+*/
+func genConstrIndices(resvar *Var, vars []*Var) (int32, []int32, error) {
+	if resvar == nil || resvar.Index < 0 {
+		return -1, nil, fmt.Errorf("invalid resultant variable given to general constraint")
+	}
+
+	ind, err := varIndices(vars)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	return resvar.Index, ind, nil
+}
+
+/*
+appendGenConstr
+Description:
+
+	Updates the model after a general constraint was added and
+	creates the GenConstr object for it.
+*/
+func (model *Model) appendGenConstr() (*GenConstr, error) {
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	gc := &GenConstr{model, int32(len(model.GenConstraints))}
+	model.GenConstraints = append(model.GenConstraints, gc)
+	return gc, nil
+}
+
+func (gc *GenConstr) GetInt(attr string) (int32, error) {
+	return gc.Model.getIntAttrElement(attr, gc.Index)
+}
+
+func (gc *GenConstr) GetDouble(attr string) (float64, error) {
+	return gc.Model.getDoubleAttrElement(attr, gc.Index)
+}
+
+func (gc *GenConstr) GetString(attr string) (string, error) {
+	return gc.Model.getStringAttrElement(attr, gc.Index)
+}
+
+func (gc *GenConstr) SetInt(attr string, value int32) error {
+	return gc.Model.setIntAttrElement(attr, gc.Index, value)
+}
+
+func (gc *GenConstr) SetDouble(attr string, value float64) error {
+	return gc.Model.setDoubleAttrElement(attr, gc.Index, value)
+}
+
+func (gc *GenConstr) SetString(attr string, value string) error {
+	return gc.Model.setStringAttrElement(attr, gc.Index, value)
+}
+
+/*
+GenConstrType
+Description:
+
+	Type of the general constraint (e.g. GENCONSTR_MAX or GENCONSTR_INDICATOR).
+*/
+func (gc *GenConstr) GenConstrType() (int32, error) {
+	return gc.GetInt("GenConstrType")
+}
+
+/*
+GenConstrName
+Description:
+
+	Name of the general constraint.
+*/
+func (gc *GenConstr) GenConstrName() (string, error) {
+	return gc.GetString("GenConstrName")
+}
@@ -0,0 +1,504 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+genconstr_function.go
+Description:
+	Functions for adding function constraints (y = f(x)) to a model. Gurobi approximates each
+	nonlinear function with a piecewise-linear function whose accuracy is controlled by the
+	FuncPieces, FuncPieceLength, FuncPieceError and FuncPieceRatio attributes.
+Notes:
+	https://www.gurobi.com/documentation/9.1/refman/constraints.html#subsubsection:GenConstrFunction
+*/
+
+// Types of function constraints (values of the GenConstrType attribute)
+const GENCONSTR_PWL = C.GRB_GENCONSTR_PWL
+const GENCONSTR_POLY = C.GRB_GENCONSTR_POLY
+const GENCONSTR_EXP = C.GRB_GENCONSTR_EXP
+const GENCONSTR_EXPA = C.GRB_GENCONSTR_EXPA
+const GENCONSTR_LOG = C.GRB_GENCONSTR_LOG
+const GENCONSTR_LOGA = C.GRB_GENCONSTR_LOGA
+const GENCONSTR_POW = C.GRB_GENCONSTR_POW
+const GENCONSTR_SIN = C.GRB_GENCONSTR_SIN
+const GENCONSTR_COS = C.GRB_GENCONSTR_COS
+const GENCONSTR_TAN = C.GRB_GENCONSTR_TAN
+
+/*
+FuncConstrOptions
+Description:
+
+	Controls how Gurobi approximates a function constraint.
+	Fields which are nil keep the model's defaults, so only the options that were set
+	(e.g. with FuncConstrOptions{}.WithFuncPieces(10)) are given to Gurobi.
+	Passing nil to one of the AddGenConstr* functions keeps all of the defaults.
+*/
+type FuncConstrOptions struct {
+	FuncPieces      *int32   // Strategy for the approximation (0 = use FuncPieceLength / FuncPieceError, >= 2 = number of pieces, 1 = use FuncPieceLength, -1 = use FuncPieceError)
+	FuncPieceLength *float64 // Length of each piece of the approximation
+	FuncPieceError  *float64 // Maximum allowed error of the approximation
+	FuncPieceRatio  *float64 // Where the approximation lies (0 = underestimate, 1 = overestimate, -1 = at the breakpoints)
+}
+
+/*
+DefaultFuncConstrOptions
+Description:
+
+	Returns the options that Gurobi uses by default, with every field set.
+*/
+func DefaultFuncConstrOptions() FuncConstrOptions {
+	return FuncConstrOptions{}.
+		WithFuncPieces(0).
+		WithFuncPieceLength(0.01).
+		WithFuncPieceError(0.001).
+		WithFuncPieceRatio(-1)
+}
+
+// WithFuncPieces returns a copy of opts with FuncPieces set to value.
+func (opts FuncConstrOptions) WithFuncPieces(value int32) FuncConstrOptions {
+	opts.FuncPieces = &value
+	return opts
+}
+
+// WithFuncPieceLength returns a copy of opts with FuncPieceLength set to value.
+func (opts FuncConstrOptions) WithFuncPieceLength(value float64) FuncConstrOptions {
+	opts.FuncPieceLength = &value
+	return opts
+}
+
+// WithFuncPieceError returns a copy of opts with FuncPieceError set to value.
+func (opts FuncConstrOptions) WithFuncPieceError(value float64) FuncConstrOptions {
+	opts.FuncPieceError = &value
+	return opts
+}
+
+// WithFuncPieceRatio returns a copy of opts with FuncPieceRatio set to value.
+func (opts FuncConstrOptions) WithFuncPieceRatio(value float64) FuncConstrOptions {
+	opts.FuncPieceRatio = &value
+	return opts
+}
+
+/*
+String
+Description:
+
+	Creates the options string expected by the C api (e.g. "FuncPieces=0 FuncPieceLength=0.01 ...").
+	Only the fields which are set appear in the string.
+*/
+func (opts FuncConstrOptions) String() string {
+	fields := []string{}
+	if opts.FuncPieces != nil {
+		fields = append(fields, fmt.Sprintf("FuncPieces=%v", *opts.FuncPieces))
+	}
+	if opts.FuncPieceLength != nil {
+		fields = append(fields, fmt.Sprintf("FuncPieceLength=%v", *opts.FuncPieceLength))
+	}
+	if opts.FuncPieceError != nil {
+		fields = append(fields, fmt.Sprintf("FuncPieceError=%v", *opts.FuncPieceError))
+	}
+	if opts.FuncPieceRatio != nil {
+		fields = append(fields, fmt.Sprintf("FuncPieceRatio=%v", *opts.FuncPieceRatio))
+	}
+	return strings.Join(fields, " ")
+}
+
+/*
+toCOptions
+Description:
+
+	Converts the (optional) options into the C string that the C api expects.
+	A nil input (or one without any field set) becomes a NULL pointer.
+*/
+func (opts *FuncConstrOptions) toCOptions() *C.char {
+	if opts == nil {
+		return nil
+	}
+
+	options := opts.String()
+	if options == "" {
+		return nil
+	}
+	return C.CString(options)
+}
+
+/*
+AddGenConstrPWL
+Description:
+
+	Adds the constraint y = f(x) where f is the piecewise-linear function through
+	the points (xpts[i], ypts[i]). The xpts must be non-decreasing.
+	Uses the GRBaddgenconstrPWL() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrpwl.html
+*/
+func (model *Model) AddGenConstrPWL(xvar *Var, yvar *Var, xpts []float64, ypts []float64, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	if len(xpts) != len(ypts) {
+		return nil, MismatchedLengthError{
+			Length1: len(xpts),
+			Length2: len(ypts),
+			Name1:   "xpts",
+			Name2:   "ypts",
+		}
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	pxpts := (*C.double)(nil)
+	pypts := (*C.double)(nil)
+	if len(xpts) > 0 {
+		pxpts = (*C.double)(&xpts[0])
+		pypts = (*C.double)(&ypts[0])
+	}
+
+	errCode := C.GRBaddgenconstrPWL(
+		model.AsGRBModel, C.CString(name),
+		C.int(xInd), C.int(yInd),
+		C.int(len(xpts)), pxpts, pypts,
+	)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrPoly
+Description:
+
+	Adds the constraint y = p[0] x^(n-1) + p[1] x^(n-2) + ... + p[n-1] to the model.
+	Uses the GRBaddgenconstrPoly() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrpoly.html
+*/
+func (model *Model) AddGenConstrPoly(xvar *Var, yvar *Var, p []float64, name string, options *FuncConstrOptions) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	pp := (*C.double)(nil)
+	if len(p) > 0 {
+		pp = (*C.double)(&p[0])
+	}
+
+	errCode := C.GRBaddgenconstrPoly(
+		model.AsGRBModel, C.CString(name),
+		C.int(xInd), C.int(yInd),
+		C.int(len(p)), pp, options.toCOptions(),
+	)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrExp
+Description:
+
+	Adds the constraint y = exp(x) to the model.
+	Uses the GRBaddgenconstrExp() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrexp.html
+*/
+func (model *Model) AddGenConstrExp(xvar *Var, yvar *Var, name string, options *FuncConstrOptions) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	errCode := C.GRBaddgenconstrExp(model.AsGRBModel, C.CString(name), C.int(xInd), C.int(yInd), options.toCOptions())
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrExpA
+Description:
+
+	Adds the constraint y = a^x (with a > 0) to the model.
+	Uses the GRBaddgenconstrExpA() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrexpa.html
+*/
+func (model *Model) AddGenConstrExpA(xvar *Var, yvar *Var, a float64, name string, options *FuncConstrOptions) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	errCode := C.GRBaddgenconstrExpA(model.AsGRBModel, C.CString(name), C.int(xInd), C.int(yInd), C.double(a), options.toCOptions())
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrLog
+Description:
+
+	Adds the constraint y = ln(x) to the model.
+	Uses the GRBaddgenconstrLog() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrlog.html
+*/
+func (model *Model) AddGenConstrLog(xvar *Var, yvar *Var, name string, options *FuncConstrOptions) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	errCode := C.GRBaddgenconstrLog(model.AsGRBModel, C.CString(name), C.int(xInd), C.int(yInd), options.toCOptions())
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrLogA
+Description:
+
+	Adds the constraint y = log_a(x) (with a > 0) to the model.
+	Uses the GRBaddgenconstrLogA() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrloga.html
+*/
+func (model *Model) AddGenConstrLogA(xvar *Var, yvar *Var, a float64, name string, options *FuncConstrOptions) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	errCode := C.GRBaddgenconstrLogA(model.AsGRBModel, C.CString(name), C.int(xInd), C.int(yInd), C.double(a), options.toCOptions())
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrPow
+Description:
+
+	Adds the constraint y = x^a to the model.
+	Uses the GRBaddgenconstrPow() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrpow.html
+*/
+func (model *Model) AddGenConstrPow(xvar *Var, yvar *Var, a float64, name string, options *FuncConstrOptions) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	errCode := C.GRBaddgenconstrPow(model.AsGRBModel, C.CString(name), C.int(xInd), C.int(yInd), C.double(a), options.toCOptions())
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrSin
+Description:
+
+	Adds the constraint y = sin(x) to the model.
+	Uses the GRBaddgenconstrSin() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrsin.html
+*/
+func (model *Model) AddGenConstrSin(xvar *Var, yvar *Var, name string, options *FuncConstrOptions) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	errCode := C.GRBaddgenconstrSin(model.AsGRBModel, C.CString(name), C.int(xInd), C.int(yInd), options.toCOptions())
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrCos
+Description:
+
+	Adds the constraint y = cos(x) to the model.
+	Uses the GRBaddgenconstrCos() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrcos.html
+*/
+func (model *Model) AddGenConstrCos(xvar *Var, yvar *Var, name string, options *FuncConstrOptions) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	errCode := C.GRBaddgenconstrCos(model.AsGRBModel, C.CString(name), C.int(xInd), C.int(yInd), options.toCOptions())
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+AddGenConstrTan
+Description:
+
+	Adds the constraint y = tan(x) to the model.
+	Uses the GRBaddgenconstrTan() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addgenconstrtan.html
+*/
+func (model *Model) AddGenConstrTan(xvar *Var, yvar *Var, name string, options *FuncConstrOptions) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	xInd, yInd, err := funcConstrIndices(xvar, yvar)
+	if err != nil {
+		return nil, err
+	}
+
+	errCode := C.GRBaddgenconstrTan(model.AsGRBModel, C.CString(name), C.int(xInd), C.int(yInd), options.toCOptions())
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.appendGenConstr()
+}
+
+/*
+funcConstrIndices
+Description:
+
+	Checks the input and output variables of a function constraint and
+	returns their indices in the Gurobi model.
+*/
+func funcConstrIndices(xvar *Var, yvar *Var) (int32, int32, error) {
+	ind, err := varIndices([]*Var{xvar, yvar})
+	if err != nil {
+		return -1, -1, fmt.Errorf("invalid xvar or yvar given to function constraint: %w", err)
+	}
+	return ind[0], ind[1], nil
+}
+
+/*
+SetFuncConstrOptions
+Description:
+
+	Sets the FuncPieces, FuncPieceLength, FuncPieceError and FuncPieceRatio
+	attributes of an existing function constraint. Fields of options which are nil are left unchanged.
+*/
+func (gc *GenConstr) SetFuncConstrOptions(options FuncConstrOptions) error {
+	if options.FuncPieces != nil {
+		if err := gc.SetInt("FuncPieces", *options.FuncPieces); err != nil {
+			return err
+		}
+	}
+	if options.FuncPieceLength != nil {
+		if err := gc.SetDouble("FuncPieceLength", *options.FuncPieceLength); err != nil {
+			return err
+		}
+	}
+	if options.FuncPieceError != nil {
+		if err := gc.SetDouble("FuncPieceError", *options.FuncPieceError); err != nil {
+			return err
+		}
+	}
+	if options.FuncPieceRatio != nil {
+		if err := gc.SetDouble("FuncPieceRatio", *options.FuncPieceRatio); err != nil {
+			return err
+		}
+	}
+
+	return gc.Model.Update()
+}
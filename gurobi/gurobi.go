@@ -8,14 +8,13 @@ const INT_ATTR_STATUS = C.GRB_INT_ATTR_STATUS
 const DBL_ATTR_OBJVAL = C.GRB_DBL_ATTR_OBJVAL
 const DBL_ATTR_X = C.GRB_DBL_ATTR_X
 
-const OPTIMAL = C.GRB_OPTIMAL
-const INF_OR_UNBD = C.GRB_INF_OR_UNBD
-
 const BINARY = C.GRB_BINARY
 const INTEGER = C.GRB_INTEGER
 const CONTINUOUS = C.GRB_CONTINUOUS
 
 const INFINITY = 1e100
+const UNDEFINED = C.GRB_UNDEFINED
+const MAX_STRLEN = C.GRB_MAX_STRLEN
 
 const MAXIMIZE = C.GRB_MAXIMIZE
 const MINIMIZE = C.GRB_MINIMIZE
@@ -0,0 +1,199 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+/*
+iis.go
+Description:
+	Functions for computing an Irreducible Inconsistent Subsystem (IIS) of an infeasible model,
+	i.e. a subset of the constraints and bounds which is infeasible on its own but becomes
+	feasible when any single member is removed.
+Notes:
+	More information about the IIS is given on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/c_computeiis.html
+*/
+
+// IISMember is a constraint (or variable bound) which participates in the IIS.
+type IISMember struct {
+	Index int32  // Index of the constraint or variable in the Gurobi model
+	Name  string // Name of the constraint or variable (empty for SOS constraints)
+}
+
+// IISReport lists every part of the model which participates in the IIS.
+type IISReport struct {
+	Minimal     bool // False when the IIS computation was stopped early (e.g. by a time limit)
+	Constrs     []IISMember
+	LowerBounds []IISMember // Variables whose lower bound participates in the IIS
+	UpperBounds []IISMember // Variables whose upper bound participates in the IIS
+	QConstrs    []IISMember
+	SOSs        []IISMember
+	GenConstrs  []IISMember
+}
+
+/*
+ComputeIIS
+Description:
+
+	Computes an IIS of the (infeasible) model and collects its members.
+	Uses the GRBcomputeIIS() method from the C api.
+	The returned error matches ErrIISNotInfeasible (see errors.Is) if the model is feasible.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_computeiis.html
+*/
+func (model *Model) ComputeIIS() (IISReport, error) {
+	err := model.Check()
+	if err != nil {
+		return IISReport{}, model.MakeUninitializedError()
+	}
+
+	errCode := C.GRBcomputeIIS(model.AsGRBModel)
+	if errCode != 0 {
+		return IISReport{}, model.MakeError(errCode)
+	}
+
+	minimal, err := model.GetIntAttr("IISMinimal")
+	if err != nil {
+		return IISReport{}, err
+	}
+	report := IISReport{Minimal: minimal != 0}
+
+	numVars := len(model.Variables)
+	if report.LowerBounds, err = model.iisMembers("IISLB", "VarName", numVars); err != nil {
+		return report, err
+	}
+	if report.UpperBounds, err = model.iisMembers("IISUB", "VarName", numVars); err != nil {
+		return report, err
+	}
+	if report.Constrs, err = model.iisMembers("IISConstr", "ConstrName", len(model.Constraints)); err != nil {
+		return report, err
+	}
+	if report.QConstrs, err = model.iisMembers("IISQConstr", "QCName", len(model.QConstraints)); err != nil {
+		return report, err
+	}
+	if report.SOSs, err = model.iisMembers("IISSOS", "", len(model.SOSConstraints)); err != nil {
+		return report, err
+	}
+	if report.GenConstrs, err = model.iisMembers("IISGenConstr", "GenConstrName", len(model.GenConstraints)); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+/*
+iisMembers
+Description:
+
+	Reads the IIS flag iisAttr of the first n elements and returns the flagged ones
+	together with their names (read from nameAttr, if it is not empty).
+*/
+func (model *Model) iisMembers(iisAttr string, nameAttr string, n int) ([]IISMember, error) {
+	flags, err := model.GetIntAttrArray(iisAttr, 0, n)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	if nameAttr != "" {
+		names, err = model.GetStringAttrArray(nameAttr, 0, n)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	members := []IISMember{}
+	for i, flag := range flags {
+		if flag == 0 {
+			continue
+		}
+		member := IISMember{Index: int32(i)}
+		if names != nil {
+			member.Name = names[i]
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+/*
+Len
+Description:
+
+	Returns the total number of constraints and bounds in the IIS.
+*/
+func (report IISReport) Len() int {
+	return len(report.Constrs) + len(report.LowerBounds) + len(report.UpperBounds) +
+		len(report.QConstrs) + len(report.SOSs) + len(report.GenConstrs)
+}
+
+/*
+String
+Description:
+
+	Summarizes the IIS with one line for each of its members.
+*/
+func (report IISReport) String() string {
+	var sb strings.Builder
+	if report.Minimal {
+		fmt.Fprintf(&sb, "IIS with %v members:\n", report.Len())
+	} else {
+		fmt.Fprintf(&sb, "IIS (not minimal) with %v members:\n", report.Len())
+	}
+
+	sections := []struct {
+		label   string
+		members []IISMember
+	}{
+		{"constraint", report.Constrs},
+		{"lower bound of", report.LowerBounds},
+		{"upper bound of", report.UpperBounds},
+		{"quadratic constraint", report.QConstrs},
+		{"SOS constraint", report.SOSs},
+		{"general constraint", report.GenConstrs},
+	}
+	for _, section := range sections {
+		for _, member := range section.members {
+			fmt.Fprintf(&sb, "  %v %v (index %v)\n", section.label, member.Name, member.Index)
+		}
+	}
+	return sb.String()
+}
+
+/*
+WriteIIS
+Description:
+
+	Computes an IIS of the (infeasible) model if necessary and writes it to filename.
+	The file name must have the .ilp extension (optionally followed by a compression
+	extension such as .gz).
+	Uses the GRBwrite() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_write.html
+*/
+func (model *Model) WriteIIS(filename string) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	base := filename
+	switch filepath.Ext(base) {
+	case ".gz", ".bz2", ".zip", ".7z":
+		base = strings.TrimSuffix(base, filepath.Ext(base))
+	}
+	if filepath.Ext(base) != ".ilp" {
+		return fmt.Errorf("the IIS can only be written to an .ilp file; received %v", filename)
+	}
+
+	return model.Write(filename)
+}
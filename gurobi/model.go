@@ -5,15 +5,23 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"runtime/cgo"
 )
 
 // Model ...
 // Gurobi model object
 type Model struct {
-	AsGRBModel  *C.GRBmodel
-	Env         Env
-	Variables   []Var
-	Constraints []Constr
+	AsGRBModel     *C.GRBmodel
+	Env            Env
+	Variables      []*Var
+	Constraints    []*Constr
+	QConstraints   []*QConstr
+	GenConstraints []*GenConstr
+	SOSConstraints []*SOS
+
+	callback       CallbackFunc
+	callbackHandle cgo.Handle
+	callbackErr    error
 }
 
 /*
@@ -74,6 +82,84 @@ func NewModel(modelname string, env *Env) (*Model, error) {
 	return &Model{AsGRBModel: model, Env: Env{newenv}}, nil
 }
 
+/*
+ReadModel
+Description:
+
+	Reads a model from a file (e.g. .lp, .mps, .rew or compressed versions of these) into a new Model.
+	Uses the GRBreadmodel() method from the C api.
+	The handles in Variables, Constraints, QConstraints, GenConstraints and SOSConstraints are created
+	from the model's NumVars, NumConstrs, NumQConstrs, NumGenConstrs and NumSOS attributes,
+	so they can be used as soon as the model is read.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_readmodel.html
+*/
+func ReadModel(env *Env, filename string) (*Model, error) {
+	err := env.Check()
+	if err != nil {
+		return nil, env.MakeUninitializedError()
+	}
+
+	var model *C.GRBmodel
+	errcode := C.GRBreadmodel(env.env, C.CString(filename), &model)
+	if errcode != 0 {
+		return nil, env.MakeError(errcode)
+	}
+
+	newenv := C.GRBgetenv(model)
+	if newenv == nil {
+		C.GRBfreemodel(model)
+		return nil, errors.New("Failed retrieve the environment")
+	}
+
+	newModel := &Model{AsGRBModel: model, Env: Env{newenv}}
+	if err := newModel.populateHandles(); err != nil {
+		newModel.Free()
+		return nil, err
+	}
+
+	return newModel, nil
+}
+
+/*
+populateHandles
+Description:
+
+	Creates a handle for every variable and constraint which was added to the model outside of Go
+	(for example by ReadModel or FeasRelax). Existing handles are kept; only the objects beyond
+	the last existing handle get new ones.
+*/
+func (model *Model) populateHandles() error {
+	counts := make(map[string]int32)
+	for _, attr := range []string{"NumVars", "NumConstrs", "NumQConstrs", "NumGenConstrs", "NumSOS"} {
+		count, err := model.GetIntAttr(attr)
+		if err != nil {
+			return err
+		}
+		counts[attr] = count
+	}
+
+	for i := int32(len(model.Variables)); i < counts["NumVars"]; i++ {
+		model.appendVar()
+	}
+	for i := int32(len(model.Constraints)); i < counts["NumConstrs"]; i++ {
+		model.appendConstr()
+	}
+	for i := int32(len(model.QConstraints)); i < counts["NumQConstrs"]; i++ {
+		model.QConstraints = append(model.QConstraints, &QConstr{model, i})
+	}
+	for i := int32(len(model.GenConstraints)); i < counts["NumGenConstrs"]; i++ {
+		model.GenConstraints = append(model.GenConstraints, &GenConstr{model, i})
+	}
+	for i := int32(len(model.SOSConstraints)); i < counts["NumSOS"]; i++ {
+		model.SOSConstraints = append(model.SOSConstraints, &SOS{model, i})
+	}
+
+	return nil
+}
+
 // Free ...
 // free the model
 func (model *Model) Free() {
@@ -81,6 +167,7 @@ func (model *Model) Free() {
 		return
 	}
 	C.GRBfreemodel(model.AsGRBModel)
+	model.releaseCallback()
 }
 
 /*
@@ -136,10 +223,7 @@ func (model *Model) AddVar(vtype int8, obj float64, lb float64, ub float64, name
 		return nil, err
 	}
 
-	fmt.Println("dummy-2")
-
-	model.Variables = append(model.Variables, Var{model, int32(len(model.Variables))})
-	return &model.Variables[len(model.Variables)-1], nil
+	return model.appendVar(), nil
 }
 
 /*
@@ -221,10 +305,8 @@ func (model *Model) AddVars(vtypes []int8, objs []float64, lbs []float64, ubs []
 	//fmt.Printf("len(vtypes)=%v\n", len(vtypes))
 
 	vars := make([]*Var, len(vtypes))
-	xcols := len(model.Variables)
-	for i := xcols; i < xcols+len(vtypes); i++ {
-		model.Variables = append(model.Variables, Var{model, int32(i)})
-		vars[i] = &model.Variables[len(model.Variables)-1]
+	for i := range vars {
+		vars[i] = model.appendVar()
 	}
 	return vars, nil
 }
@@ -354,8 +436,7 @@ func (model *Model) AddConstr(vars []*Var, val []float64, sense int8, rhs float6
 		return nil, err
 	}
 
-	model.Constraints = append(model.Constraints, Constr{model, int32(len(model.Constraints))})
-	return &model.Constraints[len(model.Constraints)-1], nil
+	return model.appendConstr(), nil
 }
 
 /*
@@ -435,10 +516,8 @@ func (model *Model) AddConstrs(vars [][]*Var, vals [][]float64, senses []int8, r
 	}
 
 	constrs := make([]*Constr, len(constrnames))
-	xrows := len(model.Constraints)
-	for i := xrows; i < xrows+len(constrnames); i++ {
-		model.Constraints = append(model.Constraints, Constr{model, int32(i)})
-		constrs[i] = &model.Constraints[len(model.Constraints)-1]
+	for i := range constrs {
+		constrs[i] = model.appendConstr()
 	}
 	return constrs, nil
 }
@@ -519,6 +598,337 @@ func (model *Model) InputChecking_AddConstrs(vars [][]*Var, vals [][]float64, se
 	return nil
 }
 
+/*
+AddRangeConstr
+Description:
+
+	Add a range constraint (lower <= vars * vals <= upper) into the model.
+	Uses the GRBaddrangeconstr() method from the C api.
+	Gurobi stores a range constraint as an equality constraint together with a new range variable
+	(named "Rg" + constrname), so this also adds an element to model.Variables.
+
+Inputs:
+  - vars: A slice of variable arrays which provide the indices for the gurobi model's variables.
+  - vals: A slice of float values which are used as coefficients for the variables in the linear constraint.
+  - lower: The lower bound on the linear expression.
+  - upper: The upper bound on the linear expression.
+  - constrname: An optional name for the constraint.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addrangeconstr.html
+*/
+func (model *Model) AddRangeConstr(vars []*Var, vals []float64, lower float64, upper float64, constrname string) (*Constr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	if len(vars) != len(vals) {
+		return nil, MismatchedLengthError{
+			Length1: len(vars),
+			Length2: len(vals),
+			Name1:   "vars",
+			Name2:   "vals",
+		}
+	}
+
+	ind, err := varIndices(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	pind := (*C.int)(nil)
+	pval := (*C.double)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+		pval = (*C.double)(&vals[0])
+	}
+
+	errCode := C.GRBaddrangeconstr(
+		model.AsGRBModel,
+		C.int(len(ind)), pind, pval,
+		C.double(lower), C.double(upper), C.CString(constrname),
+	)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	// Track the range variable that Gurobi created for this constraint.
+	model.appendVar()
+
+	return model.appendConstr(), nil
+}
+
+/*
+ChgCoeffs
+Description:
+
+	Changes the coefficients of existing linear constraints.
+	The coefficient of vars[i] in constrs[i] becomes vals[i].
+	Uses the GRBchgcoeffs() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_chgcoeffs.html
+*/
+func (model *Model) ChgCoeffs(constrs []*Constr, vars []*Var, vals []float64) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	// Check the length of each of the slices.
+	if len(constrs) != len(vars) {
+		return MismatchedLengthError{
+			Length1: len(constrs),
+			Length2: len(vars),
+			Name1:   "constrs",
+			Name2:   "vars",
+		}
+	}
+
+	if len(vars) != len(vals) {
+		return MismatchedLengthError{
+			Length1: len(vars),
+			Length2: len(vals),
+			Name1:   "vars",
+			Name2:   "vals",
+		}
+	}
+
+	if len(constrs) == 0 {
+		return nil
+	}
+
+	cind := make([]int32, len(constrs))
+	for i, c := range constrs {
+		if c == nil || c.Index < 0 {
+			return fmt.Errorf("invalid constraint given at position %v", i)
+		}
+		cind[i] = c.Index
+	}
+
+	vind, err := varIndices(vars)
+	if err != nil {
+		return err
+	}
+
+	errCode := C.GRBchgcoeffs(
+		model.AsGRBModel, C.int(len(cind)),
+		(*C.int)(&cind[0]), (*C.int)(&vind[0]), (*C.double)(&vals[0]),
+	)
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+
+	return model.Update()
+}
+
+/*
+GetCoeff
+Description:
+
+	Retrieves the coefficient of variable v in the linear constraint constr.
+	Uses the GRBgetcoeff() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getcoeff.html
+*/
+func (model *Model) GetCoeff(constr *Constr, v *Var) (float64, error) {
+	err := model.Check()
+	if err != nil {
+		return 0, model.MakeUninitializedError()
+	}
+
+	if constr == nil || constr.Index < 0 {
+		return 0, errors.New("invalid constraint given to GetCoeff()")
+	}
+	if v == nil || v.Index < 0 {
+		return 0, errors.New("invalid variable given to GetCoeff()")
+	}
+
+	var value C.double
+	errCode := C.GRBgetcoeff(model.AsGRBModel, C.int(constr.Index), C.int(v.Index), &value)
+	if errCode != 0 {
+		return 0, model.MakeError(errCode)
+	}
+	return float64(value), nil
+}
+
+/*
+GetRow
+Description:
+
+	Retrieves the variables and coefficients that make up the left hand side
+	of the linear constraint constr.
+	Uses the GRBgetconstrs() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getconstrs.html
+*/
+func (model *Model) GetRow(constr *Constr) ([]*Var, []float64, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, nil, model.MakeUninitializedError()
+	}
+
+	if constr == nil || constr.Index < 0 {
+		return nil, nil, errors.New("invalid constraint given to GetRow()")
+	}
+
+	// Find the number of nonzeros first.
+	var numnz, cbeg C.int
+	errCode := C.GRBgetconstrs(model.AsGRBModel, &numnz, &cbeg, nil, nil, C.int(constr.Index), 1)
+	if errCode != 0 {
+		return nil, nil, model.MakeError(errCode)
+	}
+
+	cind := make([]int32, int(numnz))
+	cval := make([]float64, int(numnz))
+	if numnz > 0 {
+		errCode = C.GRBgetconstrs(
+			model.AsGRBModel, &numnz, &cbeg,
+			(*C.int)(&cind[0]), (*C.double)(&cval[0]),
+			C.int(constr.Index), 1,
+		)
+		if errCode != 0 {
+			return nil, nil, model.MakeError(errCode)
+		}
+	}
+
+	vars := make([]*Var, len(cind))
+	for i, idx := range cind {
+		if int(idx) >= len(model.Variables) {
+			return nil, nil, fmt.Errorf("variable %v of the row is not in model.Variables", idx)
+		}
+		vars[i] = model.Variables[idx]
+	}
+
+	return vars, cval, nil
+}
+
+/*
+DelVars
+Description:
+
+	Deletes the given variables from the model.
+	Uses the GRBdelvars() method from the C api.
+	Gurobi shifts every later variable down to fill the gaps, so this also compacts model.Variables:
+	the handles of deleted variables get Index -1 and the handles of the remaining
+	variables are renumbered so that they keep referring to the same variable.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_delvars.html
+*/
+func (model *Model) DelVars(vars []*Var) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	ind := make([]int32, len(vars))
+	deleted := make(map[int32]bool, len(vars))
+	for i, v := range vars {
+		if !model.ownsVar(v) {
+			return fmt.Errorf("invalid variable given at position %v", i)
+		}
+		ind[i] = v.Index
+		deleted[v.Index] = true
+	}
+
+	if len(ind) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBdelvars(model.AsGRBModel, C.int(len(ind)), (*C.int)(&ind[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+
+	if err := model.Update(); err != nil {
+		return err
+	}
+
+	// Renumber the surviving handles and drop the deleted ones.
+	remaining := make([]*Var, 0, len(model.Variables)-len(deleted))
+	for _, v := range model.Variables {
+		if deleted[v.Index] {
+			v.Index = -1
+			continue
+		}
+		v.Index = int32(len(remaining))
+		remaining = append(remaining, v)
+	}
+	model.Variables = remaining
+
+	return nil
+}
+
+/*
+DelConstrs
+Description:
+
+	Deletes the given linear constraints from the model.
+	Uses the GRBdelconstrs() method from the C api.
+	Like DelVars, this compacts model.Constraints: the handles of deleted constraints get
+	Index -1 and the handles of the remaining constraints are renumbered.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_delconstrs.html
+*/
+func (model *Model) DelConstrs(constrs []*Constr) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	ind := make([]int32, len(constrs))
+	deleted := make(map[int32]bool, len(constrs))
+	for i, c := range constrs {
+		if !model.ownsConstr(c) {
+			return fmt.Errorf("invalid constraint given at position %v", i)
+		}
+		ind[i] = c.Index
+		deleted[c.Index] = true
+	}
+
+	if len(ind) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBdelconstrs(model.AsGRBModel, C.int(len(ind)), (*C.int)(&ind[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+
+	if err := model.Update(); err != nil {
+		return err
+	}
+
+	// Renumber the surviving handles and drop the deleted ones.
+	remaining := make([]*Constr, 0, len(model.Constraints)-len(deleted))
+	for _, c := range model.Constraints {
+		if deleted[c.Index] {
+			c.Index = -1
+			continue
+		}
+		c.Index = int32(len(remaining))
+		remaining = append(remaining, c)
+	}
+	model.Constraints = remaining
+
+	return nil
+}
+
 // SetObjective ...
 func (model *Model) SetObjective(objectiveExpr interface{}, sense int32) error {
 
@@ -663,6 +1073,12 @@ func (model *Model) Optimize() error {
 		return errors.New("")
 	}
 	err := C.GRBoptimize(model.AsGRBModel)
+
+	// An error from the Go callback is more informative than Gurobi's error code.
+	if cbErr := model.callbackErr; cbErr != nil {
+		model.callbackErr = nil
+		return cbErr
+	}
 	if err != 0 {
 		return model.MakeError(err)
 	}
@@ -681,6 +1097,33 @@ func (model *Model) Write(filename string) error {
 	return nil
 }
 
+/*
+Read
+Description:
+
+	Reads data from a file into an existing model.
+	Uses the GRBread() method from the C api.
+	The type of data is determined by the file suffix: MIP starts (.mst or .sol), variable hints (.hnt),
+	simplex bases (.bas), priority orders (.ord) and parameter settings (.prm).
+	Use ReadModel to read the model itself.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_read.html
+*/
+func (model *Model) Read(filename string) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	errCode := C.GRBread(model.AsGRBModel, C.CString(filename))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
 // GetIntAttr ...
 func (model *Model) GetIntAttr(attrname string) (int32, error) {
 	if model == nil {
@@ -756,30 +1199,6 @@ func (model *Model) SetStringAttr(attrname string, value string) error {
 	return nil
 }
 
-// GetDoubleAttrVars ...
-func (model *Model) GetDoubleAttrVars(attrname string, vars []*Var) ([]float64, error) {
-	ind := make([]int32, len(vars))
-	for i, v := range vars {
-		if v.Index < 0 {
-			return []float64{}, errors.New("")
-		}
-		ind[i] = v.Index
-	}
-	return model.getDoubleAttrList(attrname, ind)
-}
-
-// SetDoubleAttrVars ...
-func (model *Model) SetDoubleAttrVars(attrname string, vars []*Var, value []float64) error {
-	ind := make([]int32, len(vars))
-	for i, v := range vars {
-		if v.Index < 0 {
-			return errors.New("")
-		}
-		ind[i] = v.Index
-	}
-	return model.setDoubleAttrList(attrname, ind, value)
-}
-
 func (model *Model) getIntAttrElement(attr string, ind int32) (int32, error) {
 	if model == nil {
 		return 0.0, model.MakeUninitializedError()
@@ -872,40 +1291,64 @@ func (model *Model) setStringAttrElement(attr string, ind int32, value string) e
 	return nil
 }
 
-func (model *Model) getDoubleAttrList(attrname string, ind []int32) ([]float64, error) {
-	if model == nil {
-		return []float64{}, errors.New("")
-	}
-	if len(ind) == 0 {
-		return []float64{}, nil
-	}
-	value := make([]float64, len(ind))
-	err := C.GRBgetdblattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.double)(&value[0]))
-	if err != 0 {
-		return []float64{}, model.MakeError(err)
-	}
-	return value, nil
-}
+/*
+GetVarByName
+Description:
 
-func (model *Model) setDoubleAttrList(attrname string, ind []int32, value []float64) error {
-	if model == nil {
-		return errors.New("")
-	}
-	if len(ind) != len(value) {
-		return errors.New("")
+	Collects the GRBVar which has the given gurobi variable by name.
+	Uses the GRBgetvarbyname() method from the C api.
+	Returns an error if no variable has the given name.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getvarbyname.html
+*/
+func (model *Model) GetVarByName(name string) (*Var, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
 	}
-	if len(ind) == 0 {
-		return nil
+
+	var idx C.int
+	errCode := C.GRBgetvarbyname(model.AsGRBModel, C.CString(name), &idx)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
 	}
-	err := C.GRBsetdblattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.double)(&value[0]))
-	if err != 0 {
-		return model.MakeError(err)
+
+	if idx < 0 || int(idx) >= len(model.Variables) {
+		return nil, fmt.Errorf("there is no variable named \"%v\" in the model", name)
 	}
-	return nil
+
+	return model.Variables[idx], nil
 }
 
 /*
-GetVarByName
+GetConstrByName
 Description:
-	Collects the GRBVar which has the given gurobi variable by name.
+
+	Collects the linear constraint which has the given name.
+	Uses the GRBgetconstrbyname() method from the C api.
+	Returns an error if no linear constraint has the given name.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getconstrbyname.html
 */
+func (model *Model) GetConstrByName(name string) (*Constr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	var idx C.int
+	errCode := C.GRBgetconstrbyname(model.AsGRBModel, C.CString(name), &idx)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	if idx < 0 || int(idx) >= len(model.Constraints) {
+		return nil, fmt.Errorf("there is no linear constraint named \"%v\" in the model", name)
+	}
+
+	return model.Constraints[idx], nil
+}
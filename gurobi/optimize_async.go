@@ -0,0 +1,141 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import "context"
+
+/*
+optimize_async.go
+Description:
+	Functions for running an optimization in the background and stopping it early.
+Notes:
+	While an asynchronous optimization is running only a few attributes (e.g. Status, ObjVal, ObjBound)
+	may be queried; see Gurobi's website for the details:
+	https://www.gurobi.com/documentation/current/refman/c_optimizeasync.html
+*/
+
+/*
+OptimizeAsync
+Description:
+
+	Starts optimizing the model in a background thread and returns immediately.
+	Sync() must be called before the model is modified or its solution is queried.
+	Uses the GRBoptimizeasync() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_optimizeasync.html
+*/
+func (model *Model) OptimizeAsync() error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	errCode := C.GRBoptimizeasync(model.AsGRBModel)
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+Sync
+Description:
+
+	Waits for an optimization started by OptimizeAsync() to finish.
+	Uses the GRBsync() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_sync.html
+*/
+func (model *Model) Sync() error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	errCode := C.GRBsync(model.AsGRBModel)
+
+	// An error from the Go callback is more informative than Gurobi's error code.
+	if cbErr := model.callbackErr; cbErr != nil {
+		model.callbackErr = nil
+		return cbErr
+	}
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+Terminate
+Description:
+
+	Asks Gurobi to stop a running optimization as soon as possible.
+	It is safe to call this from a different goroutine than the one which is optimizing.
+	Uses the GRBterminate() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_terminate.html
+*/
+func (model *Model) Terminate() {
+	if model.Check() != nil {
+		return
+	}
+	C.GRBterminate(model.AsGRBModel)
+}
+
+/*
+OptimizeContext
+Description:
+
+	Optimizes the model until it is solved or ctx is done, whichever happens first.
+	When ctx is cancelled (or its deadline passes) the optimization is terminated and
+	ctx.Err() is returned once Gurobi has stopped. The model's Status is then INTERRUPTED
+	and the best solution found so far (if any) can still be queried.
+	If Gurobi finished the optimization before the termination took effect, nil is
+	returned instead and the model's Status is the one of the completed solve.
+*/
+func (model *Model) OptimizeContext(ctx context.Context) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	// Do not start an optimization that is already cancelled.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := model.OptimizeAsync(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- model.Sync()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		model.Terminate()
+		if err := <-done; err != nil {
+			return err
+		}
+
+		// The optimization may have finished before the termination took effect.
+		status, err := model.Status()
+		if err != nil {
+			return err
+		}
+		if status.IsTerminal() && status != INTERRUPTED {
+			return nil
+		}
+		return ctx.Err()
+	}
+}
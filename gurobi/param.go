@@ -0,0 +1,359 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+/*
+param.go
+Description:
+	Functions for inspecting the parameters of an environment.
+	Parameter names and their types, bounds and defaults come from the Gurobi library itself,
+	so every parameter of the installed version can be validated without a hard-coded list.
+Notes:
+	The available parameters are listed on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/parameters.html
+*/
+
+// Types of parameters (as returned by GRBgetparamtype())
+const (
+	PARAM_TYPE_INT    = 1
+	PARAM_TYPE_DOUBLE = 2
+	PARAM_TYPE_STRING = 3
+)
+
+// IntParamInfo describes the current value and the allowed range of an integer parameter.
+type IntParamInfo struct {
+	Name    string
+	Value   int32
+	Min     int32
+	Max     int32
+	Default int32
+}
+
+// DBLParamInfo describes the current value and the allowed range of a double parameter.
+type DBLParamInfo struct {
+	Name    string
+	Value   float64
+	Min     float64
+	Max     float64
+	Default float64
+}
+
+// StrParamInfo describes the current and the default value of a string parameter.
+type StrParamInfo struct {
+	Name    string
+	Value   string
+	Default string
+}
+
+/*
+GetParamType
+Description:
+
+	Returns the type of the parameter with the name paramName
+	(PARAM_TYPE_INT, PARAM_TYPE_DOUBLE or PARAM_TYPE_STRING).
+	Uses the GRBgetparamtype() method from the C api.
+	Returns an error if Gurobi does not know the parameter.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getparamtype.html
+*/
+func (env *Env) GetParamType(paramName string) (int, error) {
+	if err := env.Check(); err != nil {
+		return -1, env.MakeUninitializedError()
+	}
+
+	paramType := int(C.GRBgetparamtype(env.env, C.CString(paramName)))
+	switch paramType {
+	case PARAM_TYPE_INT, PARAM_TYPE_DOUBLE, PARAM_TYPE_STRING:
+		return paramType, nil
+	default:
+		return -1, fmt.Errorf("%v is not a valid Gurobi parameter", paramName)
+	}
+}
+
+/*
+checkParamType
+Description:
+
+	Returns an error unless paramName is a parameter of the expected type.
+*/
+func (env *Env) checkParamType(paramName string, expected int) error {
+	paramType, err := env.GetParamType(paramName)
+	if err != nil {
+		return err
+	}
+
+	if paramType != expected {
+		return fmt.Errorf(
+			"%v is a %v parameter, not a %v parameter",
+			paramName, paramTypeName(paramType), paramTypeName(expected),
+		)
+	}
+
+	return nil
+}
+
+func paramTypeName(paramType int) string {
+	switch paramType {
+	case PARAM_TYPE_INT:
+		return "int"
+	case PARAM_TYPE_DOUBLE:
+		return "double"
+	case PARAM_TYPE_STRING:
+		return "string"
+	default:
+		return "unknown"
+	}
+}
+
+/*
+GetIntParamInfo
+Description:
+
+	Retrieves the current value, minimum, maximum and default of an integer parameter.
+	Uses the GRBgetintparaminfo() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getintparaminfo.html
+*/
+func (env *Env) GetIntParamInfo(paramName string) (IntParamInfo, error) {
+	if err := env.Check(); err != nil {
+		return IntParamInfo{}, env.MakeUninitializedError()
+	}
+
+	if err := env.checkParamType(paramName, PARAM_TYPE_INT); err != nil {
+		return IntParamInfo{}, err
+	}
+
+	var value, min, max, def C.int
+	errcode := C.GRBgetintparaminfo(env.env, C.CString(paramName), &value, &min, &max, &def)
+	if errcode != 0 {
+		return IntParamInfo{}, env.MakeError(errcode)
+	}
+
+	return IntParamInfo{
+		Name:    paramName,
+		Value:   int32(value),
+		Min:     int32(min),
+		Max:     int32(max),
+		Default: int32(def),
+	}, nil
+}
+
+/*
+GetDBLParamInfo
+Description:
+
+	Retrieves the current value, minimum, maximum and default of a double parameter.
+	Uses the GRBgetdblparaminfo() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getdblparaminfo.html
+*/
+func (env *Env) GetDBLParamInfo(paramName string) (DBLParamInfo, error) {
+	if err := env.Check(); err != nil {
+		return DBLParamInfo{}, env.MakeUninitializedError()
+	}
+
+	if err := env.checkParamType(paramName, PARAM_TYPE_DOUBLE); err != nil {
+		return DBLParamInfo{}, err
+	}
+
+	var value, min, max, def C.double
+	errcode := C.GRBgetdblparaminfo(env.env, C.CString(paramName), &value, &min, &max, &def)
+	if errcode != 0 {
+		return DBLParamInfo{}, env.MakeError(errcode)
+	}
+
+	return DBLParamInfo{
+		Name:    paramName,
+		Value:   float64(value),
+		Min:     float64(min),
+		Max:     float64(max),
+		Default: float64(def),
+	}, nil
+}
+
+/*
+GetStrParamInfo
+Description:
+
+	Retrieves the current value and the default of a string parameter.
+	Uses the GRBgetstrparaminfo() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getstrparaminfo.html
+*/
+func (env *Env) GetStrParamInfo(paramName string) (StrParamInfo, error) {
+	if err := env.Check(); err != nil {
+		return StrParamInfo{}, env.MakeUninitializedError()
+	}
+
+	if err := env.checkParamType(paramName, PARAM_TYPE_STRING); err != nil {
+		return StrParamInfo{}, err
+	}
+
+	value := make([]byte, MAX_STRLEN)
+	def := make([]byte, MAX_STRLEN)
+	errcode := C.GRBgetstrparaminfo(
+		env.env, C.CString(paramName),
+		(*C.char)(unsafe.Pointer(&value[0])), (*C.char)(unsafe.Pointer(&def[0])),
+	)
+	if errcode != 0 {
+		return StrParamInfo{}, env.MakeError(errcode)
+	}
+
+	return StrParamInfo{
+		Name:    paramName,
+		Value:   C.GoString((*C.char)(unsafe.Pointer(&value[0]))),
+		Default: C.GoString((*C.char)(unsafe.Pointer(&def[0]))),
+	}, nil
+}
+
+/*
+ModelParams
+Description:
+
+	Gives access to the parameters of a single model.
+	Gurobi copies the environment when a model is created, so parameters set on the Env passed to NewModel
+	afterwards do not affect the model. The methods of ModelParams act on the model's own copy instead.
+*/
+type ModelParams struct {
+	Model *Model
+}
+
+/*
+Params
+Description:
+
+	Returns the parameter API of the model, e.g. model.Params().SetDouble("TimeLimit", 60).
+*/
+func (model *Model) Params() ModelParams {
+	return ModelParams{Model: model}
+}
+
+/*
+env
+Description:
+
+	Returns the environment of the model, or an error if the model is not initialized.
+*/
+func (mp ModelParams) env() (*Env, error) {
+	if err := mp.Model.Check(); err != nil {
+		return nil, mp.Model.MakeUninitializedError()
+	}
+	return &mp.Model.Env, nil
+}
+
+func (mp ModelParams) SetInt(paramName string, val int32) error {
+	env, err := mp.env()
+	if err != nil {
+		return err
+	}
+	return env.SetIntParam(paramName, val)
+}
+
+func (mp ModelParams) GetInt(paramName string) (int32, error) {
+	env, err := mp.env()
+	if err != nil {
+		return -1, err
+	}
+	return env.GetIntParam(paramName)
+}
+
+func (mp ModelParams) SetDouble(paramName string, val float64) error {
+	env, err := mp.env()
+	if err != nil {
+		return err
+	}
+	return env.SetDBLParam(paramName, val)
+}
+
+func (mp ModelParams) GetDouble(paramName string) (float64, error) {
+	env, err := mp.env()
+	if err != nil {
+		return -1, err
+	}
+	return env.GetDBLParam(paramName)
+}
+
+func (mp ModelParams) SetString(paramName string, val string) error {
+	env, err := mp.env()
+	if err != nil {
+		return err
+	}
+	return env.SetStrParam(paramName, val)
+}
+
+func (mp ModelParams) GetString(paramName string) (string, error) {
+	env, err := mp.env()
+	if err != nil {
+		return "", err
+	}
+	return env.GetStrParam(paramName)
+}
+
+/*
+Set
+Description:
+
+	Sets a parameter of any type from its string representation (see Env.SetParam).
+*/
+func (mp ModelParams) Set(paramName string, val string) error {
+	env, err := mp.env()
+	if err != nil {
+		return err
+	}
+	return env.SetParam(paramName, val)
+}
+
+/*
+Capture
+Description:
+
+	Returns a ParamSet with the current value of every parameter of the model.
+*/
+func (mp ModelParams) Capture() (*ParamSet, error) {
+	env, err := mp.env()
+	if err != nil {
+		return nil, err
+	}
+	return env.CaptureParams()
+}
+
+/*
+Apply
+Description:
+
+	Sets every parameter of ps on the model.
+*/
+func (mp ModelParams) Apply(ps *ParamSet) error {
+	env, err := mp.env()
+	if err != nil {
+		return err
+	}
+	return ps.Apply(env)
+}
+
+/*
+Read
+Description:
+
+	Reads parameter settings from a .prm file into the model.
+*/
+func (mp ModelParams) Read(filename string) error {
+	env, err := mp.env()
+	if err != nil {
+		return err
+	}
+	return env.ReadParams(filename)
+}
@@ -0,0 +1,299 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+
+/*
+paramset.go
+Description:
+	Functions for saving, loading and comparing complete sets of parameters.
+	A ParamSet holds the values of parameters by type and can be written to JSON,
+	compared with the defaults of the library and applied to any environment.
+*/
+
+// ParamSet holds parameter values grouped by the type of the parameter.
+type ParamSet struct {
+	Int    map[string]int32   `json:"int,omitempty"`
+	Double map[string]float64 `json:"double,omitempty"`
+	String map[string]string  `json:"string,omitempty"`
+}
+
+/*
+NewParamSet
+Description:
+
+	Creates an empty ParamSet.
+*/
+func NewParamSet() *ParamSet {
+	return &ParamSet{
+		Int:    make(map[string]int32),
+		Double: make(map[string]float64),
+		String: make(map[string]string),
+	}
+}
+
+/*
+Len
+Description:
+
+	Returns the number of parameters in the set.
+*/
+func (ps *ParamSet) Len() int {
+	return len(ps.Int) + len(ps.Double) + len(ps.String)
+}
+
+/*
+ReadParams
+Description:
+
+	Reads parameter settings from a file (usually with the suffix .prm) into the environment.
+	Uses the GRBreadparams() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_readparams.html
+*/
+func (env *Env) ReadParams(filename string) error {
+	if err := env.Check(); err != nil {
+		return env.MakeUninitializedError()
+	}
+
+	errcode := C.GRBreadparams(env.env, C.CString(filename))
+	if errcode != 0 {
+		return env.MakeError(errcode)
+	}
+	return nil
+}
+
+/*
+WriteParams
+Description:
+
+	Writes the parameters of the environment which differ from their defaults to a file.
+	Uses the GRBwriteparams() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_writeparams.html
+*/
+func (env *Env) WriteParams(filename string) error {
+	if err := env.Check(); err != nil {
+		return env.MakeUninitializedError()
+	}
+
+	errcode := C.GRBwriteparams(env.env, C.CString(filename))
+	if errcode != 0 {
+		return env.MakeError(errcode)
+	}
+	return nil
+}
+
+/*
+ResetParams
+Description:
+
+	Resets all of the parameters of the environment to their default values.
+	Uses the GRBresetparams() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_resetparams.html
+*/
+func (env *Env) ResetParams() error {
+	if err := env.Check(); err != nil {
+		return env.MakeUninitializedError()
+	}
+
+	errcode := C.GRBresetparams(env.env)
+	if errcode != 0 {
+		return env.MakeError(errcode)
+	}
+	return nil
+}
+
+/*
+ParamNames
+Description:
+
+	Returns the names of all of the parameters that the Gurobi library knows.
+	Uses the GRBgetnumparams() and GRBgetparamname() methods from the C api.
+*/
+func (env *Env) ParamNames() ([]string, error) {
+	if err := env.Check(); err != nil {
+		return nil, env.MakeUninitializedError()
+	}
+
+	numParams := int(C.GRBgetnumparams(env.env))
+	names := make([]string, 0, numParams)
+	for i := 0; i < numParams; i++ {
+		var name *C.char
+		errcode := C.GRBgetparamname(env.env, C.int(i), &name)
+		if errcode != 0 {
+			return nil, env.MakeError(errcode)
+		}
+		names = append(names, C.GoString(name))
+	}
+
+	return names, nil
+}
+
+/*
+CaptureParams
+Description:
+
+	Returns a ParamSet with the current value of every parameter of the environment.
+*/
+func (env *Env) CaptureParams() (*ParamSet, error) {
+	return env.captureParams(false)
+}
+
+/*
+DefaultParams
+Description:
+
+	Returns a ParamSet with the default value of every parameter, i.e. the values that
+	ResetParams (GRBresetparams) would restore. The environment itself is not modified.
+*/
+func (env *Env) DefaultParams() (*ParamSet, error) {
+	return env.captureParams(true)
+}
+
+/*
+NonDefaultParams
+Description:
+
+	Returns a ParamSet with only the parameters of the environment whose values differ from their defaults.
+	This is useful for logging which settings were changed, e.g. after ReadParams.
+*/
+func (env *Env) NonDefaultParams() (*ParamSet, error) {
+	current, err := env.CaptureParams()
+	if err != nil {
+		return nil, err
+	}
+
+	defaults, err := env.DefaultParams()
+	if err != nil {
+		return nil, err
+	}
+
+	return current.Diff(defaults), nil
+}
+
+/*
+captureParams
+Description:
+
+	Collects either the current values or the defaults of all parameters of the environment.
+*/
+func (env *Env) captureParams(defaults bool) (*ParamSet, error) {
+	names, err := env.ParamNames()
+	if err != nil {
+		return nil, err
+	}
+
+	ps := NewParamSet()
+	for _, name := range names {
+		paramType, err := env.GetParamType(name)
+		if err != nil {
+			return nil, err
+		}
+
+		switch paramType {
+		case PARAM_TYPE_INT:
+			info, err := env.GetIntParamInfo(name)
+			if err != nil {
+				return nil, err
+			}
+			ps.Int[name] = info.Value
+			if defaults {
+				ps.Int[name] = info.Default
+			}
+		case PARAM_TYPE_DOUBLE:
+			info, err := env.GetDBLParamInfo(name)
+			if err != nil {
+				return nil, err
+			}
+			ps.Double[name] = info.Value
+			if defaults {
+				ps.Double[name] = info.Default
+			}
+		case PARAM_TYPE_STRING:
+			info, err := env.GetStrParamInfo(name)
+			if err != nil {
+				return nil, err
+			}
+			ps.String[name] = info.Value
+			if defaults {
+				ps.String[name] = info.Default
+			}
+		}
+	}
+
+	return ps, nil
+}
+
+/*
+Diff
+Description:
+
+	Returns a ParamSet with the parameters of ps whose values are missing from base or differ from it.
+*/
+func (ps *ParamSet) Diff(base *ParamSet) *ParamSet {
+	diff := NewParamSet()
+	for name, val := range ps.Int {
+		if baseVal, ok := base.Int[name]; !ok || baseVal != val {
+			diff.Int[name] = val
+		}
+	}
+	for name, val := range ps.Double {
+		if baseVal, ok := base.Double[name]; !ok || baseVal != val {
+			diff.Double[name] = val
+		}
+	}
+	for name, val := range ps.String {
+		if baseVal, ok := base.String[name]; !ok || baseVal != val {
+			diff.String[name] = val
+		}
+	}
+	return diff
+}
+
+/*
+Apply
+Description:
+
+	Sets every parameter of ps in the environment.
+	Parameters which already have the requested value are left untouched, so a ParamSet captured from
+	one environment can be applied to another without rewriting parameters that cannot change after
+	the environment has started.
+	To change the parameters of a model, apply the set to the model's environment (see ModelParams.Apply).
+*/
+func (ps *ParamSet) Apply(env *Env) error {
+	if err := env.Check(); err != nil {
+		return env.MakeUninitializedError()
+	}
+
+	current, err := env.CaptureParams()
+	if err != nil {
+		return err
+	}
+	changes := ps.Diff(current)
+
+	for name, val := range changes.Int {
+		if err := env.SetIntParam(name, val); err != nil {
+			return err
+		}
+	}
+	for name, val := range changes.Double {
+		if err := env.SetDBLParam(name, val); err != nil {
+			return err
+		}
+	}
+	for name, val := range changes.String {
+		if err := env.SetStrParam(name, val); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+qconstr.go
+Description:
+	A set of functions for creating and manipulating the gurobi QConstr object.
+Notes:
+	The available attributes for quadratic constraints are listed on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/quadratic_constraint_attr.html
+*/
+
+// Gurobi quadratic constraint object
+type QConstr struct {
+	Model *Model
+	Index int32
+}
+
+/*
+AddQConstr
+Description:
+
+	Add a quadratic constraint into the model.
+	Uses the GRBaddqconstr() method from the C api.
+	The constraint is expr (sense) rhs; any constant offset in expr is moved to the right hand side.
+
+Inputs:
+  - expr: The quadratic expression on the left hand side (linear and quadratic terms).
+  - sense: A flag which determines if this is a less than equal or greater than or equal constraint.
+  - rhs: A float value which determines the constant which is on the other side of the constraint.
+  - name: An optional name for the constraint.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addqconstr.html
+*/
+func (model *Model) AddQConstr(expr *QuadExpr, sense int8, rhs float64, name string) (*QConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	if expr == nil {
+		return nil, errors.New("the quadratic expression given to AddQConstr() was nil")
+	}
+
+	if len(expr.lind) != len(expr.lval) {
+		return nil, MismatchedLengthError{
+			Length1: len(expr.lind), Name1: "expr.lind",
+			Length2: len(expr.lval), Name2: "expr.lval",
+		}
+	}
+	if len(expr.qrow) != len(expr.qcol) || len(expr.qcol) != len(expr.qval) {
+		return nil, fmt.Errorf(
+			"the quadratic terms of expr have mismatched lengths (qrow=%v, qcol=%v, qval=%v)",
+			len(expr.qrow), len(expr.qcol), len(expr.qval),
+		)
+	}
+
+	// Collect linear part
+	lind := make([]int32, len(expr.lind))
+	for i, v := range expr.lind {
+		if v.Index < 0 {
+			return nil, fmt.Errorf("invalid index (%v) for linear variable %v of expr", v.Index, i)
+		}
+		lind[i] = v.Index
+	}
+
+	// Collect quadratic part
+	qrow := make([]int32, len(expr.qrow))
+	qcol := make([]int32, len(expr.qcol))
+	for i := range expr.qrow {
+		if expr.qrow[i].Index < 0 || expr.qcol[i].Index < 0 {
+			return nil, fmt.Errorf("invalid index in quadratic term %v of expr", i)
+		}
+		qrow[i] = expr.qrow[i].Index
+		qcol[i] = expr.qcol[i].Index
+	}
+
+	plind := (*C.int)(nil)
+	plval := (*C.double)(nil)
+	if len(lind) > 0 {
+		plind = (*C.int)(&lind[0])
+		plval = (*C.double)(&expr.lval[0])
+	}
+
+	pqrow := (*C.int)(nil)
+	pqcol := (*C.int)(nil)
+	pqval := (*C.double)(nil)
+	if len(qrow) > 0 {
+		pqrow = (*C.int)(&qrow[0])
+		pqcol = (*C.int)(&qcol[0])
+		pqval = (*C.double)(&expr.qval[0])
+	}
+
+	errCode := C.GRBaddqconstr(
+		model.AsGRBModel,
+		C.int(len(lind)), plind, plval,
+		C.int(len(qrow)), pqrow, pqcol, pqval,
+		C.char(sense), C.double(rhs-expr.offset), C.CString(name),
+	)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	qc := &QConstr{model, int32(len(model.QConstraints))}
+	model.QConstraints = append(model.QConstraints, qc)
+	return qc, nil
+}
+
+/*
+DelQConstrs
+Description:
+
+	Deletes the given quadratic constraints from the model.
+	Uses the GRBdelqconstrs() method from the C api.
+	The handles of deleted constraints get Index -1 and the remaining handles
+	are renumbered to match their new position in the Gurobi model.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_delqconstrs.html
+*/
+func (model *Model) DelQConstrs(qconstrs []*QConstr) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	ind := make([]int32, len(qconstrs))
+	deleted := make(map[int32]bool, len(qconstrs))
+	for i, qc := range qconstrs {
+		if qc == nil {
+			return fmt.Errorf("nil quadratic constraint given at position %v", i)
+		}
+		if qc.Index < 0 || int(qc.Index) >= len(model.QConstraints) || model.QConstraints[qc.Index] != qc {
+			return fmt.Errorf("invalid index (%v) for quadratic constraint %v", qc.Index, i)
+		}
+		ind[i] = qc.Index
+		deleted[qc.Index] = true
+	}
+
+	if len(ind) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBdelqconstrs(model.AsGRBModel, C.int(len(ind)), (*C.int)(&ind[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+
+	if err := model.Update(); err != nil {
+		return err
+	}
+
+	// Renumber the surviving handles and drop the deleted ones.
+	remaining := make([]*QConstr, 0, len(model.QConstraints)-len(deleted))
+	for _, qc := range model.QConstraints {
+		if deleted[qc.Index] {
+			qc.Index = -1
+			continue
+		}
+		qc.Index = int32(len(remaining))
+		remaining = append(remaining, qc)
+	}
+	model.QConstraints = remaining
+
+	return nil
+}
+
+func (qc *QConstr) GetInt(attr string) (int32, error) {
+	return qc.Model.getIntAttrElement(attr, qc.Index)
+}
+
+func (qc *QConstr) GetChar(attr string) (int8, error) {
+	return qc.Model.getCharAttrElement(attr, qc.Index)
+}
+
+func (qc *QConstr) GetDouble(attr string) (float64, error) {
+	return qc.Model.getDoubleAttrElement(attr, qc.Index)
+}
+
+func (qc *QConstr) GetString(attr string) (string, error) {
+	return qc.Model.getStringAttrElement(attr, qc.Index)
+}
+
+func (qc *QConstr) SetChar(attr string, value int8) error {
+	return qc.Model.setCharAttrElement(attr, qc.Index, value)
+}
+
+func (qc *QConstr) SetDouble(attr string, value float64) error {
+	return qc.Model.setDoubleAttrElement(attr, qc.Index, value)
+}
+
+func (qc *QConstr) SetString(attr string, value string) error {
+	return qc.Model.setStringAttrElement(attr, qc.Index, value)
+}
+
+/*
+QCSlack
+Description:
+
+	Slack of the quadratic constraint in the current solution.
+*/
+func (qc *QConstr) QCSlack() (float64, error) {
+	return qc.GetDouble("QCSlack")
+}
+
+/*
+QCPi
+Description:
+
+	Dual value of the quadratic constraint in the current solution.
+	Gurobi only computes these when the QCPDual parameter is set to 1.
+*/
+func (qc *QConstr) QCPi() (float64, error) {
+	return qc.GetDouble("QCPi")
+}
+
+/*
+QCName
+Description:
+
+	Name of the quadratic constraint.
+*/
+func (qc *QConstr) QCName() (string, error) {
+	return qc.GetString("QCName")
+}
+
+/*
+QCRHS
+Description:
+
+	Right hand side of the quadratic constraint.
+*/
+func (qc *QConstr) QCRHS() (float64, error) {
+	return qc.GetDouble("QCRHS")
+}
+
+/*
+QCSense
+Description:
+
+	Sense of the quadratic constraint (SenseLessThan, SenseGreaterThan or SenseEqual).
+*/
+func (qc *QConstr) QCSense() (int8, error) {
+	return qc.GetChar("QCSense")
+}
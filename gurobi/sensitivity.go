@@ -0,0 +1,239 @@
+package gurobi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+/*
+sensitivity.go
+Description:
+	Collects the dual information and sensitivity (ranging) attributes of a solved LP into
+	Go structs which can be written as CSV or JSON.
+Notes:
+	The sensitivity attributes are only available for continuous models; see Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/attributes.html
+*/
+
+// VarSensitivity holds the solution and ranging information of one variable.
+type VarSensitivity struct {
+	Index    int32   `json:"index"`
+	Name     string  `json:"name"`
+	X        float64 `json:"x"`
+	RC       float64 `json:"rc"`     // Reduced cost
+	VBasis   int32   `json:"vbasis"` // BASIC, NONBASIC_LOWER, NONBASIC_UPPER or SUPERBASIC
+	Obj      float64 `json:"obj"`
+	SAObjLow float64 `json:"saObjLow"` // Smallest objective coefficient for which the basis stays optimal
+	SAObjUp  float64 `json:"saObjUp"`  // Largest objective coefficient for which the basis stays optimal
+	SALBLow  float64 `json:"saLBLow"`  // Smallest lower bound for which the basis stays optimal
+	SALBUp   float64 `json:"saLBUp"`   // Largest lower bound for which the basis stays optimal
+	SAUBLow  float64 `json:"saUBLow"`  // Smallest upper bound for which the basis stays optimal
+	SAUBUp   float64 `json:"saUBUp"`   // Largest upper bound for which the basis stays optimal
+}
+
+// ConstrSensitivity holds the dual and ranging information of one linear constraint.
+type ConstrSensitivity struct {
+	Index    int32   `json:"index"`
+	Name     string  `json:"name"`
+	Pi       float64 `json:"pi"` // Dual value (shadow price)
+	Slack    float64 `json:"slack"`
+	CBasis   int32   `json:"cbasis"` // BASIC, NONBASIC_LOWER, NONBASIC_UPPER or SUPERBASIC
+	RHS      float64 `json:"rhs"`
+	SARHSLow float64 `json:"saRHSLow"` // Smallest right hand side for which the basis stays optimal
+	SARHSUp  float64 `json:"saRHSUp"`  // Largest right hand side for which the basis stays optimal
+}
+
+// SensitivityReport holds the sensitivity information of every variable and linear constraint of a solved LP.
+type SensitivityReport struct {
+	ObjVal  float64             `json:"objVal"`
+	Vars    []VarSensitivity    `json:"vars"`
+	Constrs []ConstrSensitivity `json:"constrs"`
+}
+
+/*
+BuildSensitivityReport
+Description:
+
+	Collects Pi, Slack, RC, VBasis/CBasis and the ranging attributes of every variable and
+	linear constraint after the model was solved to optimality. Each attribute is read with
+	a single call to the C api. Returns an error if the model is a MIP or was not solved.
+*/
+func (model *Model) BuildSensitivityReport() (SensitivityReport, error) {
+	err := model.Check()
+	if err != nil {
+		return SensitivityReport{}, model.MakeUninitializedError()
+	}
+
+	objVal, err := model.GetDoubleAttr("ObjVal")
+	if err != nil {
+		return SensitivityReport{}, err
+	}
+	report := SensitivityReport{ObjVal: objVal}
+
+	// Variables
+	numVars := len(model.Variables)
+	varNames, err := model.GetStringAttrArray("VarName", 0, numVars)
+	if err != nil {
+		return report, err
+	}
+
+	vbasis, err := model.GetIntAttrArray("VBasis", 0, numVars)
+	if err != nil {
+		return report, err
+	}
+
+	varDoubles, err := model.getDoubleAttrArrays(
+		numVars, "X", "RC", "Obj", "SAObjLow", "SAObjUp", "SALBLow", "SALBUp", "SAUBLow", "SAUBUp",
+	)
+	if err != nil {
+		return report, err
+	}
+
+	report.Vars = make([]VarSensitivity, numVars)
+	for i := range report.Vars {
+		report.Vars[i] = VarSensitivity{
+			Index:    int32(i),
+			Name:     varNames[i],
+			X:        varDoubles["X"][i],
+			RC:       varDoubles["RC"][i],
+			VBasis:   vbasis[i],
+			Obj:      varDoubles["Obj"][i],
+			SAObjLow: varDoubles["SAObjLow"][i],
+			SAObjUp:  varDoubles["SAObjUp"][i],
+			SALBLow:  varDoubles["SALBLow"][i],
+			SALBUp:   varDoubles["SALBUp"][i],
+			SAUBLow:  varDoubles["SAUBLow"][i],
+			SAUBUp:   varDoubles["SAUBUp"][i],
+		}
+	}
+
+	// Linear constraints
+	numConstrs := len(model.Constraints)
+	constrNames, err := model.GetStringAttrArray("ConstrName", 0, numConstrs)
+	if err != nil {
+		return report, err
+	}
+
+	cbasis, err := model.GetIntAttrArray("CBasis", 0, numConstrs)
+	if err != nil {
+		return report, err
+	}
+
+	constrDoubles, err := model.getDoubleAttrArrays(numConstrs, "Pi", "Slack", "RHS", "SARHSLow", "SARHSUp")
+	if err != nil {
+		return report, err
+	}
+
+	report.Constrs = make([]ConstrSensitivity, numConstrs)
+	for i := range report.Constrs {
+		report.Constrs[i] = ConstrSensitivity{
+			Index:    int32(i),
+			Name:     constrNames[i],
+			Pi:       constrDoubles["Pi"][i],
+			Slack:    constrDoubles["Slack"][i],
+			CBasis:   cbasis[i],
+			RHS:      constrDoubles["RHS"][i],
+			SARHSLow: constrDoubles["SARHSLow"][i],
+			SARHSUp:  constrDoubles["SARHSUp"][i],
+		}
+	}
+
+	return report, nil
+}
+
+/*
+getDoubleAttrArrays
+Description:
+
+	Reads the first n elements of each of the given double array attributes.
+*/
+func (model *Model) getDoubleAttrArrays(n int, attrnames ...string) (map[string][]float64, error) {
+	values := make(map[string][]float64, len(attrnames))
+	for _, attrname := range attrnames {
+		attrValues, err := model.GetDoubleAttrArray(attrname, 0, n)
+		if err != nil {
+			return nil, err
+		}
+		values[attrname] = attrValues
+	}
+	return values, nil
+}
+
+/*
+WriteJSON
+Description:
+
+	Writes the report to w as indented JSON.
+*/
+func (report SensitivityReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+/*
+WriteVarsCSV
+Description:
+
+	Writes the variable part of the report to w as CSV with a header row.
+*/
+func (report SensitivityReport) WriteVarsCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{
+		"index", "name", "x", "rc", "vbasis", "obj",
+		"saObjLow", "saObjUp", "saLBLow", "saLBUp", "saUBLow", "saUBUp",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range report.Vars {
+		err = writer.Write([]string{
+			strconv.Itoa(int(v.Index)), v.Name, formatFloat(v.X), formatFloat(v.RC),
+			strconv.Itoa(int(v.VBasis)), formatFloat(v.Obj),
+			formatFloat(v.SAObjLow), formatFloat(v.SAObjUp),
+			formatFloat(v.SALBLow), formatFloat(v.SALBUp),
+			formatFloat(v.SAUBLow), formatFloat(v.SAUBUp),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+/*
+WriteConstrsCSV
+Description:
+
+	Writes the constraint part of the report to w as CSV with a header row.
+*/
+func (report SensitivityReport) WriteConstrsCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{"index", "name", "pi", "slack", "cbasis", "rhs", "saRHSLow", "saRHSUp"})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range report.Constrs {
+		err = writer.Write([]string{
+			strconv.Itoa(int(c.Index)), c.Name, formatFloat(c.Pi), formatFloat(c.Slack),
+			strconv.Itoa(int(c.CBasis)), formatFloat(c.RHS),
+			formatFloat(c.SARHSLow), formatFloat(c.SARHSUp),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,254 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+)
+
+/*
+sos.go
+Description:
+	A set of functions for creating and manipulating the gurobi SOS object
+	(Special Ordered Sets of type 1 or 2).
+*/
+
+const SOS_TYPE1 = C.GRB_SOS_TYPE1
+const SOS_TYPE2 = C.GRB_SOS_TYPE2
+
+// Gurobi SOS constraint object
+type SOS struct {
+	Model *Model
+	Index int32
+}
+
+/*
+AddSOS
+Description:
+
+	Adds a single SOS constraint to the model.
+	In an SOS1 constraint at most one of vars may be nonzero; in an SOS2 constraint at most two
+	consecutive (in the order given by weights) variables may be nonzero.
+
+Inputs:
+  - sosType: SOS_TYPE1 or SOS_TYPE2.
+  - vars: The members of the set.
+  - weights: The weight of each member. These define the order of the members and must be unique.
+*/
+func (model *Model) AddSOS(sosType int, vars []*Var, weights []float64) (*SOS, error) {
+	soss, err := model.AddSOSs([]int{sosType}, [][]*Var{vars}, [][]float64{weights})
+	if err != nil {
+		return nil, err
+	}
+	return soss[0], nil
+}
+
+/*
+AddSOSs
+Description:
+
+	Adds a set of SOS constraints at once.
+	Uses the GRBaddsos() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_addsos.html
+*/
+func (model *Model) AddSOSs(sosTypes []int, vars [][]*Var, weights [][]float64) ([]*SOS, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, model.MakeUninitializedError()
+	}
+
+	// Check the length of each of the slices.
+	if len(sosTypes) != len(vars) {
+		return nil, MismatchedLengthError{
+			Length1: len(sosTypes),
+			Length2: len(vars),
+			Name1:   "sosTypes",
+			Name2:   "vars",
+		}
+	}
+
+	if len(vars) != len(weights) {
+		return nil, MismatchedLengthError{
+			Length1: len(vars),
+			Length2: len(weights),
+			Name1:   "vars",
+			Name2:   "weights",
+		}
+	}
+
+	numMembers := 0
+	for _, members := range vars {
+		numMembers += len(members)
+	}
+
+	types := make([]int32, len(sosTypes))
+	beg := make([]int32, len(sosTypes))
+	ind := make([]int32, 0, numMembers)
+	_weights := make([]float64, 0, numMembers)
+	for i := range vars {
+		if len(vars[i]) != len(weights[i]) {
+			return nil, MismatchedLengthError{
+				Length1: len(vars[i]),
+				Length2: len(weights[i]),
+				Name1:   fmt.Sprintf("vars[%v]", i),
+				Name2:   fmt.Sprintf("weights[%v]", i),
+			}
+		}
+
+		members, err := varIndices(vars[i])
+		if err != nil {
+			return nil, err
+		}
+
+		types[i] = int32(sosTypes[i])
+		beg[i] = int32(len(ind))
+		ind = append(ind, members...)
+		_weights = append(_weights, weights[i]...)
+	}
+
+	if len(types) == 0 {
+		return []*SOS{}, nil
+	}
+
+	pind := (*C.int)(nil)
+	pweights := (*C.double)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+		pweights = (*C.double)(&_weights[0])
+	}
+
+	errCode := C.GRBaddsos(
+		model.AsGRBModel,
+		C.int(len(types)), C.int(len(ind)),
+		(*C.int)(&types[0]), (*C.int)(&beg[0]), pind, pweights,
+	)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	soss := make([]*SOS, len(types))
+	for i := range soss {
+		soss[i] = &SOS{model, int32(len(model.SOSConstraints))}
+		model.SOSConstraints = append(model.SOSConstraints, soss[i])
+	}
+	return soss, nil
+}
+
+/*
+GetSOS
+Description:
+
+	Retrieves the type, members and weights of an SOS constraint.
+	Uses the GRBgetsos() method from the C api.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_getsos.html
+*/
+func (model *Model) GetSOS(sos *SOS) (int, []*Var, []float64, error) {
+	err := model.Check()
+	if err != nil {
+		return -1, nil, nil, model.MakeUninitializedError()
+	}
+
+	if sos == nil || sos.Index < 0 || int(sos.Index) >= len(model.SOSConstraints) || model.SOSConstraints[sos.Index] != sos {
+		return -1, nil, nil, fmt.Errorf("invalid SOS constraint given to GetSOS()")
+	}
+
+	// Find the number of members first.
+	var numMembers, sosType, beg C.int
+	errCode := C.GRBgetsos(model.AsGRBModel, &numMembers, &sosType, &beg, nil, nil, C.int(sos.Index), 1)
+	if errCode != 0 {
+		return -1, nil, nil, model.MakeError(errCode)
+	}
+
+	ind := make([]int32, int(numMembers))
+	weights := make([]float64, int(numMembers))
+	if numMembers > 0 {
+		errCode = C.GRBgetsos(
+			model.AsGRBModel, &numMembers, &sosType, &beg,
+			(*C.int)(&ind[0]), (*C.double)(&weights[0]),
+			C.int(sos.Index), 1,
+		)
+		if errCode != 0 {
+			return -1, nil, nil, model.MakeError(errCode)
+		}
+	}
+
+	vars := make([]*Var, len(ind))
+	for i, idx := range ind {
+		if int(idx) >= len(model.Variables) {
+			return -1, nil, nil, fmt.Errorf("SOS member %v is not in model.Variables", idx)
+		}
+		vars[i] = model.Variables[idx]
+	}
+
+	return int(sosType), vars, weights, nil
+}
+
+/*
+DelSOSs
+Description:
+
+	Deletes the given SOS constraints from the model.
+	Uses the GRBdelsos() method from the C api.
+	The handles of deleted constraints get Index -1 and the remaining handles
+	are renumbered to match their new position in the Gurobi model.
+
+Link:
+
+	https://www.gurobi.com/documentation/9.1/refman/c_delsos.html
+*/
+func (model *Model) DelSOSs(soss []*SOS) error {
+	err := model.Check()
+	if err != nil {
+		return model.MakeUninitializedError()
+	}
+
+	ind := make([]int32, len(soss))
+	deleted := make(map[int32]bool, len(soss))
+	for i, sos := range soss {
+		if sos == nil {
+			return fmt.Errorf("nil SOS constraint given at position %v", i)
+		}
+		if sos.Index < 0 || int(sos.Index) >= len(model.SOSConstraints) || model.SOSConstraints[sos.Index] != sos {
+			return fmt.Errorf("invalid index (%v) for SOS constraint %v", sos.Index, i)
+		}
+		ind[i] = sos.Index
+		deleted[sos.Index] = true
+	}
+
+	if len(ind) == 0 {
+		return nil
+	}
+
+	errCode := C.GRBdelsos(model.AsGRBModel, C.int(len(ind)), (*C.int)(&ind[0]))
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+
+	if err := model.Update(); err != nil {
+		return err
+	}
+
+	// Renumber the surviving handles and drop the deleted ones.
+	remaining := make([]*SOS, 0, len(model.SOSConstraints)-len(deleted))
+	for _, sos := range model.SOSConstraints {
+		if deleted[sos.Index] {
+			sos.Index = -1
+			continue
+		}
+		sos.Index = int32(len(remaining))
+		remaining = append(remaining, sos)
+	}
+	model.SOSConstraints = remaining
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import "fmt"
+
+/*
+status.go
+Description:
+	The optimization status codes of Gurobi (values of the Status attribute).
+Notes:
+	The meaning of each status code is listed on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/optimization_status_codes.html
+*/
+
+// Status is the optimization status of a model.
+type Status int32
+
+// The status codes are untyped constants, so they compare with both a Status and the
+// int32 returned by GetIntAttr(INT_ATTR_STATUS).
+const LOADED = C.GRB_LOADED
+const OPTIMAL = C.GRB_OPTIMAL
+const INFEASIBLE = C.GRB_INFEASIBLE
+const INF_OR_UNBD = C.GRB_INF_OR_UNBD
+const UNBOUNDED = C.GRB_UNBOUNDED
+const CUTOFF = C.GRB_CUTOFF
+const ITERATION_LIMIT = C.GRB_ITERATION_LIMIT
+const NODE_LIMIT = C.GRB_NODE_LIMIT
+const TIME_LIMIT = C.GRB_TIME_LIMIT
+const SOLUTION_LIMIT = C.GRB_SOLUTION_LIMIT
+const INTERRUPTED = C.GRB_INTERRUPTED
+const NUMERIC = C.GRB_NUMERIC
+const SUBOPTIMAL = C.GRB_SUBOPTIMAL
+const INPROGRESS = C.GRB_INPROGRESS
+const USER_OBJ_LIMIT = C.GRB_USER_OBJ_LIMIT
+
+// WORK_LIMIT and MEM_LIMIT were added in Gurobi 9.5 and 10.0, so they are defined
+// here rather than taken from gurobi_c.h to keep older headers working.
+const WORK_LIMIT = 16
+const MEM_LIMIT = 17
+
+var statusNames = map[Status]string{
+	LOADED:          "LOADED",
+	OPTIMAL:         "OPTIMAL",
+	INFEASIBLE:      "INFEASIBLE",
+	INF_OR_UNBD:     "INF_OR_UNBD",
+	UNBOUNDED:       "UNBOUNDED",
+	CUTOFF:          "CUTOFF",
+	ITERATION_LIMIT: "ITERATION_LIMIT",
+	NODE_LIMIT:      "NODE_LIMIT",
+	TIME_LIMIT:      "TIME_LIMIT",
+	SOLUTION_LIMIT:  "SOLUTION_LIMIT",
+	INTERRUPTED:     "INTERRUPTED",
+	NUMERIC:         "NUMERIC",
+	SUBOPTIMAL:      "SUBOPTIMAL",
+	INPROGRESS:      "INPROGRESS",
+	USER_OBJ_LIMIT:  "USER_OBJ_LIMIT",
+	WORK_LIMIT:      "WORK_LIMIT",
+	MEM_LIMIT:       "MEM_LIMIT",
+}
+
+/*
+String
+Description:
+
+	Returns the name of the status code (e.g. "OPTIMAL"), or "Status(n)" for unknown codes.
+*/
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Status(%d)", int32(s))
+}
+
+/*
+HasSolution
+Description:
+
+	Returns true if the status guarantees that a solution is available (OPTIMAL or SUBOPTIMAL).
+	After a limit was reached (e.g. TIME_LIMIT) a solution may or may not be available;
+	check the SolCount attribute in that case.
+*/
+func (s Status) HasSolution() bool {
+	return s == OPTIMAL || s == SUBOPTIMAL
+}
+
+/*
+IsTerminal
+Description:
+
+	Returns true if the optimization has finished, i.e. the status is anything other than
+	LOADED (not solved yet) or INPROGRESS (still running, e.g. after OptimizeAsync).
+*/
+func (s Status) IsTerminal() bool {
+	_, known := statusNames[s]
+	return known && s != LOADED && s != INPROGRESS
+}
+
+/*
+Status
+Description:
+
+	Returns the current optimization status of the model.
+*/
+func (model *Model) Status() (Status, error) {
+	err := model.Check()
+	if err != nil {
+		return -1, model.MakeUninitializedError()
+	}
+
+	status, err := model.GetIntAttr(INT_ATTR_STATUS)
+	if err != nil {
+		return -1, err
+	}
+
+	return Status(status), nil
+}
@@ -1,5 +1,7 @@
 package gurobi
 
+import "fmt"
+
 /*
 var.go
 Description:
@@ -55,3 +57,46 @@ func (v *Var) SetObj(value float64) error {
 	// Update model and return
 	return v.Model.Update()
 }
+
+/*
+varIndices
+Description:
+
+	Collects the Gurobi index of each variable in vars.
+	Returns an error if any of the variables has an invalid index.
+*/
+func varIndices(vars []*Var) ([]int32, error) {
+	ind := make([]int32, len(vars))
+	for i, v := range vars {
+		if v == nil || v.Index < 0 {
+			return nil, fmt.Errorf("invalid variable given at position %v", i)
+		}
+		ind[i] = v.Index
+	}
+	return ind, nil
+}
+
+/*
+appendVar
+Description:
+
+	Creates the handle for the variable which was just added to the Gurobi model.
+	Each handle is allocated separately, so the returned pointer stays valid
+	however much model.Variables grows.
+*/
+func (model *Model) appendVar() *Var {
+	v := &Var{model, int32(len(model.Variables))}
+	model.Variables = append(model.Variables, v)
+	return v
+}
+
+/*
+ownsVar
+Description:
+
+	Returns true if v is the current handle of one of the model's variables.
+	Handles of deleted variables (or of other models) are rejected.
+*/
+func (model *Model) ownsVar(v *Var) bool {
+	return v != nil && v.Index >= 0 && int(v.Index) < len(model.Variables) && model.Variables[v.Index] == v
+}
@@ -1,6 +1,8 @@
 package mpgSolver
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/MatProGo-dev/MatProInterface.go/optim"
 	"io"
@@ -17,6 +19,15 @@ type GurobiSolver struct {
 	CurrentModel           *gurobi.Model
 	ModelName              string
 	GoopIDToGurobiIndexMap map[uint64]int32 // Maps each Goop ID (uint64) to the idx value used for each Gurobi variable.
+
+	// Row bookkeeping: maps each gurobi (quadratic) constraint to the optim.Constraint it was created from.
+	ConstrOrigins  map[*gurobi.Constr]ConstraintOrigin
+	QConstrOrigins map[*gurobi.QConstr]ConstraintOrigin
+	NumConstraints int // Number of constraints given to AddConstraint()
+
+	// If true, Optimize() computes an IIS when the model is infeasible (or reported as INF_OR_UNBD
+	// and found to be infeasible) and returns it in an InfeasibleError.
+	ComputeIISOnInfeasible bool
 }
 
 // Function
@@ -56,7 +67,7 @@ func (gs *GurobiSolver) ShowLog(tf bool) error {
 		//Delete the old file.
 		err = os.Remove(logFileName)
 		if err != nil {
-			return fmt.Errorf("There was an issue deleting the old log file: %v", err)
+			return fmt.Errorf("There was an issue deleting the old log file: %w", err)
 		}
 	}
 
@@ -64,7 +75,7 @@ func (gs *GurobiSolver) ShowLog(tf bool) error {
 	file, err := os.OpenFile(logFileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
 	if err != nil {
 		// log.Fatal(err)
-		return fmt.Errorf("There was an issue createing a log file: %v", err)
+		return fmt.Errorf("There was an issue createing a log file: %w", err)
 	}
 
 	// Attach logger to terminal only if tf is true
@@ -86,6 +97,8 @@ SetTimeLimit
 Description:
 
 	Sets the time limit of the current model in gurobi solver gs.
+	The limit is set on the model's own environment; Gurobi copied gs.Env when the
+	model was created, so changing gs.Env would not affect the model.
 
 Input:
 
@@ -93,9 +106,9 @@ Input:
 */
 func (gs *GurobiSolver) SetTimeLimit(limitInS float64) error {
 
-	err := gs.Env.SetDBLParam("TimeLimit", limitInS)
+	err := gs.CurrentModel.Params().SetDouble("TimeLimit", limitInS)
 	if err != nil {
-		return fmt.Errorf("There was an issue using SetDBLParam(): %v", err)
+		return fmt.Errorf("There was an issue setting the TimeLimit of the model: %w", err)
 	}
 
 	// If there was no error, return nil
@@ -118,9 +131,9 @@ Output
 */
 func (gs *GurobiSolver) GetTimeLimit() (float64, error) {
 
-	limitOut, err := gs.Env.GetDBLParam("TimeLimit")
+	limitOut, err := gs.CurrentModel.Params().GetDouble("TimeLimit")
 	if err != nil {
-		return -1, fmt.Errorf("There was an error getting the double param TimeLimit: %v", err)
+		return -1, fmt.Errorf("There was an error getting the double param TimeLimit: %w", err)
 	}
 
 	// If all things succeeded, return good data.
@@ -149,8 +162,11 @@ func (gs *GurobiSolver) CreateModel(modelName string) {
 	}
 	gs.CurrentModel = model
 
-	// Create an empty map
+	// Create empty maps
 	gs.GoopIDToGurobiIndexMap = make(map[uint64]int32)
+	gs.ConstrOrigins = make(map[*gurobi.Constr]ConstraintOrigin)
+	gs.QConstrOrigins = make(map[*gurobi.QConstr]ConstraintOrigin)
+	gs.NumConstraints = 0
 
 }
 
@@ -203,7 +219,7 @@ func (gs *GurobiSolver) AddVariable(varIn optim.Variable) error {
 	// Convert Variable Type
 	vType, err := VarTypeToGRBVType(varIn.Vtype)
 	if err != nil {
-		return fmt.Errorf("There was an error defining gurobi type: %v", err)
+		return fmt.Errorf("There was an error defining gurobi type: %w", err)
 	}
 
 	// Add Variable to Current Model
@@ -226,6 +242,8 @@ func VarTypeToGRBVType(vtype optim.VarType) (int8, error) {
 		return gurobi.CONTINUOUS, nil
 	case optim.Binary:
 		return gurobi.BINARY, nil
+	case optim.Integer:
+		return gurobi.INTEGER, nil
 	}
 
 	return gurobi.BINARY, fmt.Errorf("Unexpected mpg variable type for conversion: %v", vtype)
@@ -245,7 +263,7 @@ func (gs *GurobiSolver) AddVariables(varSliceIn []optim.Variable) error {
 		err := gs.AddVariable(tempVar)
 		if err != nil {
 			// Terminate early.
-			return fmt.Errorf("Error in AddVariable(): %v", err)
+			return fmt.Errorf("Error in AddVariable(): %w", err)
 		}
 	}
 
@@ -258,6 +276,9 @@ AddConstraint
 Description:
 
 	Adds a single constraint to the gurobi model object inside of the current GurobiSolver object.
+	Each row created for the constraint is recorded in gs.ConstrOrigins (or gs.QConstrOrigins)
+	and named after the constraint's position, e.g. "constraint3" or "constraint3[1]" for
+	the second element of a vector constraint.
 */
 func (gs *GurobiSolver) AddConstraint(constrIn optim.Constraint, errors ...error) error {
 	// Input Checking
@@ -266,6 +287,23 @@ func (gs *GurobiSolver) AddConstraint(constrIn optim.Constraint, errors ...error
 		return err
 	}
 
+	origin := ConstraintOrigin{Index: gs.NumConstraints, Constraint: constrIn, Element: -1}
+	err = gs.addConstraint(constrIn, origin)
+	if err != nil {
+		return err
+	}
+
+	gs.NumConstraints++
+	return nil
+}
+
+/*
+addConstraint
+Description:
+
+	Adds constrIn to the gurobi model and records origin for each of the rows it creates.
+*/
+func (gs *GurobiSolver) addConstraint(constrIn optim.Constraint, origin ConstraintOrigin) error {
 	if !optim.IsConstraint(constrIn) {
 		return fmt.Errorf("The input to AddConstr is not recognized as a constraint!")
 	}
@@ -283,7 +321,7 @@ func (gs *GurobiSolver) AddConstraint(constrIn optim.Constraint, errors ...error
 		}
 
 		if tf, _ := simplifiedConstr.IsLinear(); !tf {
-			return fmt.Errorf("cannot handle quadratic constraints yet in Gurobi.go; create an issue if you want this feature!")
+			return gs.addQuadraticConstraint(simplifiedConstr, origin)
 		}
 
 		gurobiVarSlice, L, senseOut, C, err := gs.ToGurobiLinearConstraint(simplifiedConstr)
@@ -297,13 +335,14 @@ func (gs *GurobiSolver) AddConstraint(constrIn optim.Constraint, errors ...error
 		}
 
 		// Call Gurobi library's AddConstr() function
-		_, err = gs.CurrentModel.AddConstr(
+		gurobiConstr, err := gs.CurrentModel.AddConstr(
 			gurobiVarSlice, L, senseOut, C,
-			fmt.Sprintf("goop Constraint #%v", len(gs.CurrentModel.Constraints)),
+			origin.RowName(),
 		)
 		if err != nil {
-			return fmt.Errorf("There was an issue with adding the constraint to the gurobi model: %v", err)
+			return fmt.Errorf("There was an issue with adding the constraint to the gurobi model: %w", err)
 		}
+		gs.ConstrOrigins[gurobiConstr] = origin
 	case optim.VectorConstraint:
 		// Cast
 		constrAsVC, _ := constrIn.(optim.VectorConstraint)
@@ -320,7 +359,9 @@ func (gs *GurobiSolver) AddConstraint(constrIn optim.Constraint, errors ...error
 				return err
 			}
 
-			err = gs.AddConstraint(tempConstr)
+			elementOrigin := origin
+			elementOrigin.Element = vecIdx
+			err = gs.addConstraint(tempConstr, elementOrigin)
 			if err != nil {
 				return err
 			}
@@ -366,7 +407,7 @@ func (gs *GurobiSolver) SetObjective(objIn optim.Objective) error {
 		// Add linear expression to the objective.
 		err := gs.CurrentModel.SetLinearObjective(gurobiLE, int32(objIn.Sense))
 		if err != nil {
-			return fmt.Errorf("There was an issue setting the linear objective with SetLinearObjective(): %v", err)
+			return fmt.Errorf("There was an issue setting the linear objective with SetLinearObjective(): %w", err)
 		}
 
 		return nil
@@ -416,7 +457,7 @@ func (gs *GurobiSolver) SetObjective(objIn optim.Objective) error {
 
 		err := gs.CurrentModel.SetQuadraticObjective(gurobiQE, int32(objIn.Sense))
 		if err != nil {
-			return fmt.Errorf("There was an issue setting the quadratic objective with SetQuadraticObjective(): %v", err)
+			return fmt.Errorf("There was an issue setting the quadratic objective with SetQuadraticObjective(): %w", err)
 		}
 
 		return nil
@@ -434,37 +475,90 @@ func (gs *GurobiSolver) Optimize() (optim.Solution, error) {
 	// Make sure that all changes are applied to the given model.
 	err := gs.CurrentModel.Update()
 	if err != nil {
-		return optim.Solution{}, fmt.Errorf("There was an issue updating the current gurobi model: %v", err)
+		return optim.Solution{}, fmt.Errorf("There was an issue updating the current gurobi model: %w", err)
 	}
 
 	// Optimize
 	err = gs.CurrentModel.Optimize()
 	if err != nil {
-		return optim.Solution{}, fmt.Errorf("There was an issue optimizing the current model: %v", err)
+		return optim.Solution{}, fmt.Errorf("There was an issue optimizing the current model: %w", err)
 	}
 
+	return gs.collectSolution()
+}
+
+/*
+OptimizeContext
+Description:
+
+	Optimizes the current model like Optimize(), but stops early when ctx is cancelled
+	or its deadline passes. In that case the returned error wraps ctx.Err() and the
+	solution holds the best values found before the optimization was stopped (if any).
+*/
+func (gs *GurobiSolver) OptimizeContext(ctx context.Context) (optim.Solution, error) {
+	// Make sure that all changes are applied to the given model.
+	err := gs.CurrentModel.Update()
+	if err != nil {
+		return optim.Solution{}, fmt.Errorf("There was an issue updating the current gurobi model: %w", err)
+	}
+
+	// Optimize
+	optErr := gs.CurrentModel.OptimizeContext(ctx)
+	if optErr != nil && ctx.Err() == nil {
+		return optim.Solution{}, fmt.Errorf("There was an issue optimizing the current model: %w", optErr)
+	}
+
+	tempSolution, err := gs.collectSolution()
+	if optErr != nil {
+		return tempSolution, fmt.Errorf("The optimization was stopped before it finished: %w", optErr)
+	}
+	return tempSolution, err
+}
+
+/*
+collectSolution
+Description:
+
+	Builds the optim.Solution (status, values and objective) of the current model
+	after an optimization.
+*/
+func (gs *GurobiSolver) collectSolution() (optim.Solution, error) {
 	// Construct solution:
 	// - Status
 	tempSolution := optim.Solution{}
-	tempStatus, err := gs.CurrentModel.GetIntAttr("Status")
+	tempStatus, err := gs.CurrentModel.Status()
 	if err != nil {
-		return tempSolution, fmt.Errorf("There was an issue collecting the model's status: %v", err)
+		return tempSolution, fmt.Errorf("There was an issue collecting the model's status: %w", err)
 	}
 	tempSolution.Status = optim.OptimizationStatus(tempStatus)
 
-	// - Values
-	tempValues := make(map[uint64]float64)
-	for _, tempGurobiVar := range gs.CurrentModel.Variables {
-		val, err := tempGurobiVar.GetDouble("X")
-		if err != nil {
-			return tempSolution, fmt.Errorf("Error while retrieving the optimal values of the problem: %v", err)
+	// - Diagnosis of infeasible models
+	//   With dual reductions on, presolve may only report INF_OR_UNBD for an infeasible model,
+	//   so the IIS is attempted for that status too. If Gurobi finds the model feasible, it was
+	//   unbounded and the plain result is returned.
+	if gs.ComputeIISOnInfeasible && (tempStatus == gurobi.INFEASIBLE || tempStatus == gurobi.INF_OR_UNBD) {
+		diagnosis, err := gs.ComputeIIS()
+		switch {
+		case err == nil:
+			tempSolution.Status = optim.OptimizationStatus_INFEASIBLE
+			return tempSolution, InfeasibleError{Diagnosis: diagnosis}
+		case tempStatus == gurobi.INF_OR_UNBD && errors.Is(err, gurobi.ErrIISNotInfeasible):
+			// The model is unbounded; fall through to the plain result.
+		default:
+			return tempSolution, fmt.Errorf("The model is infeasible and there was an issue computing its IIS: %w", err)
 		}
-		// identify goop index that has this gurobi variables data
-		for goopIndex, gurobiIndex := range gs.GoopIDToGurobiIndexMap {
-			if gurobiIndex == tempGurobiVar.Index {
-				tempValues[goopIndex] = val
-				break // When you find it, save the value and return the value to the map.
-			}
+	}
+
+	// - Values
+	xs, err := gs.CurrentModel.GetDoubleAttrArray("X", 0, len(gs.CurrentModel.Variables))
+	if err != nil {
+		return tempSolution, fmt.Errorf("Error while retrieving the optimal values of the problem: %w", err)
+	}
+
+	tempValues := make(map[uint64]float64, len(gs.GoopIDToGurobiIndexMap))
+	for goopIndex, gurobiIndex := range gs.GoopIDToGurobiIndexMap {
+		if int(gurobiIndex) < len(xs) {
+			tempValues[goopIndex] = xs[gurobiIndex]
 		}
 	}
 	tempSolution.Values = tempValues
@@ -472,7 +566,7 @@ func (gs *GurobiSolver) Optimize() (optim.Solution, error) {
 	// - Objective
 	tempObjective, err := gs.CurrentModel.GetDoubleAttr("ObjVal")
 	if err != nil {
-		return tempSolution, fmt.Errorf("There was an issue getting the objective value of the current model.")
+		return tempSolution, fmt.Errorf("There was an issue getting the objective value of the current model: %w", err)
 	}
 	tempSolution.Objective = tempObjective
 
@@ -510,7 +604,7 @@ func Solve(model optim.Model) (optim.Solution, GurobiSolver, error) {
 	if err != nil {
 		return optim.Solution{},
 			solver,
-			fmt.Errorf("error adding MPG variables to gurobi model: %v", err)
+			fmt.Errorf("error adding MPG variables to gurobi model: %w", err)
 	}
 
 	// Add Constraints
@@ -520,8 +614,8 @@ func Solve(model optim.Model) (optim.Solution, GurobiSolver, error) {
 			return optim.Solution{},
 				solver,
 				fmt.Errorf(
-					"there was an issue adding %v-th constraint: %v",
-					i, constraint,
+					"there was an issue adding %v-th constraint (%v): %w",
+					i, constraint, err,
 				)
 		}
 	}
@@ -532,7 +626,7 @@ func Solve(model optim.Model) (optim.Solution, GurobiSolver, error) {
 		return optim.Solution{},
 			solver,
 			fmt.Errorf(
-				"there was an issue adding the model's objective: %v", err,
+				"there was an issue adding the model's objective: %w", err,
 			)
 	}
 
@@ -542,7 +636,7 @@ func Solve(model optim.Model) (optim.Solution, GurobiSolver, error) {
 		return optim.Solution{},
 			solver,
 			fmt.Errorf(
-				"there was an issue with optimizing the model: %v",
+				"there was an issue with optimizing the model: %w",
 				err,
 			)
 	}
@@ -579,9 +673,9 @@ func (gs *GurobiSolver) ToGurobiLinearConstraint(constr optim.ScalarConstraint)
 			fmt.Printf("Gurobi Index: %v, MPG Index: %v\n", tempGurobiIdx, tempGoopID)
 
 			// Locate the gurobi variable in the current model that has matching ID
-			for jj, tempGurobiVar := range gs.CurrentModel.Variables {
+			for _, tempGurobiVar := range gs.CurrentModel.Variables {
 				if tempGurobiIdx == tempGurobiVar.Index {
-					tempVarSlice[GoopIdx] = &(gs.CurrentModel.Variables[jj])
+					tempVarSlice[GoopIdx] = tempGurobiVar
 					newL[GoopIdx] = left.L.AtVec(GoopIdx)
 				}
 			}
@@ -600,3 +694,115 @@ func (gs *GurobiSolver) ToGurobiLinearConstraint(constr optim.ScalarConstraint)
 	}
 
 }
+
+/*
+addQuadraticConstraint
+Description:
+
+	Adds a (simplified) quadratic scalar constraint to the gurobi model inside of gs.
+*/
+func (gs *GurobiSolver) addQuadraticConstraint(constr optim.ScalarConstraint, origin ConstraintOrigin) error {
+	// Convert constraint
+	gurobiQE, senseOut, rhs, err := gs.ToGurobiQuadraticConstraint(constr)
+	if err != nil {
+		return err
+	}
+
+	// Call Gurobi library's AddQConstr() function
+	gurobiQConstr, err := gs.CurrentModel.AddQConstr(gurobiQE, senseOut, rhs, origin.RowName())
+	if err != nil {
+		return fmt.Errorf("There was an issue with adding the quadratic constraint to the gurobi model: %w", err)
+	}
+	gs.QConstrOrigins[gurobiQConstr] = origin
+
+	return nil
+}
+
+/*
+ToGurobiQuadraticConstraint
+Description:
+
+	Converts a scalar constraint with a quadratic left hand side (after simplification) into
+	the quadratic expression, sense and right hand side used by gurobi's AddQConstr().
+	The constant of the left hand side is moved into the right hand side.
+*/
+func (gs *GurobiSolver) ToGurobiQuadraticConstraint(constr optim.ScalarConstraint) (
+	*gurobi.QuadExpr, int8, float64, error,
+) {
+	// constr
+	constrSimplified, err := constr.Simplify()
+	if err != nil {
+		return nil, int8(-1), -1, err
+	}
+
+	rhs, tf := constrSimplified.RightHandSide.(optim.K)
+	if !tf {
+		return nil, int8(-1), -1, fmt.Errorf(
+			"expected the right hand side of the simplified constraint to be a constant; received %T",
+			constrSimplified.RightHandSide,
+		)
+	}
+
+	left, tf := constrSimplified.LeftHandSide.(optim.ScalarQuadraticExpression)
+	if !tf {
+		return nil, int8(-1), -1, fmt.Errorf(
+			"unexpected left hand side input of type %T",
+			constrSimplified.LeftHandSide,
+		)
+	}
+
+	// Collect the gurobi variable for each element of left.X
+	gurobiVars := make([]*gurobi.Var, left.X.Len())
+	for ii, tempGoopID := range left.IDs() {
+		gurobiVars[ii], err = gs.gurobiVarFromGoopID(tempGoopID)
+		if err != nil {
+			return nil, int8(-1), -1, err
+		}
+	}
+
+	// Create quadratic part (x' * Q * x)
+	gurobiQE := &gurobi.QuadExpr{}
+	for ii := range gurobiVars {
+		for jj := range gurobiVars {
+			qij := left.Q.At(ii, jj)
+			if qij == 0.0 {
+				continue
+			}
+			gurobiQE = gurobiQE.AddQTerm(gurobiVars[ii], gurobiVars[jj], qij)
+		}
+	}
+
+	// Create linear part (L * x)
+	for ii := range gurobiVars {
+		lii := left.L.AtVec(ii)
+		if lii == 0.0 {
+			continue
+		}
+		gurobiQE = gurobiQE.AddTerm(gurobiVars[ii], lii)
+	}
+
+	// Return (the constant of the left hand side is moved to the right)
+	return gurobiQE, int8(constrSimplified.Sense), float64(rhs) - left.C, nil
+}
+
+/*
+gurobiVarFromGoopID
+Description:
+
+	Finds the variable in the current gurobi model which corresponds to the given Goop ID.
+*/
+func (gs *GurobiSolver) gurobiVarFromGoopID(goopID uint64) (*gurobi.Var, error) {
+	gurobiIdx, tf := gs.GoopIDToGurobiIndexMap[goopID]
+	if !tf {
+		return nil, fmt.Errorf("the variable with ID %v has not been added to the gurobi model", goopID)
+	}
+
+	if gurobiIdx < 0 || int(gurobiIdx) >= len(gs.CurrentModel.Variables) {
+		return nil, fmt.Errorf(
+			"the variable with ID %v maps to gurobi index %v, but the model only has %v variables",
+			goopID, gurobiIdx, len(gs.CurrentModel.Variables),
+		)
+	}
+
+	return gs.CurrentModel.Variables[gurobiIdx], nil
+}
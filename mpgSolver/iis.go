@@ -0,0 +1,142 @@
+package mpgSolver
+
+import (
+	"fmt"
+	"strings"
+
+	gurobi "github.com/MatProGo-dev/Gurobi.go/gurobi"
+	"github.com/MatProGo-dev/MatProInterface.go/optim"
+)
+
+/*
+iis.go
+Description:
+	Translates the IIS (Irreducible Inconsistent Subsystem) of an infeasible gurobi model
+	back into the MatProInterface constraints and variables that it was built from.
+*/
+
+// Type Definitions
+
+/*
+ConstraintOrigin
+Description:
+
+	Describes the optim.Constraint which produced a row of the gurobi model.
+*/
+type ConstraintOrigin struct {
+	Index      int              // Position of the constraint among those given to AddConstraint()
+	Constraint optim.Constraint // The constraint given to AddConstraint()
+	Element    int              // Element of a VectorConstraint which produced the row (-1 for scalar constraints)
+}
+
+/*
+IISDiagnosis
+Description:
+
+	The IIS of an infeasible model, expressed with the constraints and variable IDs
+	of the MatProInterface model.
+*/
+type IISDiagnosis struct {
+	Constraints   []ConstraintOrigin // Origins of the linear and quadratic rows in the IIS
+	LowerBoundIDs []uint64           // IDs of the variables whose lower bound is in the IIS
+	UpperBoundIDs []uint64           // IDs of the variables whose upper bound is in the IIS
+	Report        gurobi.IISReport   // The IIS as reported by gurobi
+}
+
+/*
+InfeasibleError
+Description:
+
+	Returned by Optimize() for infeasible models when gs.ComputeIISOnInfeasible is set.
+*/
+type InfeasibleError struct {
+	Diagnosis IISDiagnosis
+}
+
+// Functions
+
+/*
+RowName
+Description:
+
+	Name used for the gurobi row(s) created from this constraint.
+*/
+func (origin ConstraintOrigin) RowName() string {
+	if origin.Element < 0 {
+		return fmt.Sprintf("constraint%v", origin.Index)
+	}
+	return fmt.Sprintf("constraint%v[%v]", origin.Index, origin.Element)
+}
+
+func (err InfeasibleError) Error() string {
+	rowNames := make([]string, len(err.Diagnosis.Constraints))
+	for i, origin := range err.Diagnosis.Constraints {
+		rowNames[i] = origin.RowName()
+	}
+	return fmt.Sprintf(
+		"the model is infeasible; the IIS contains the constraints [%v], the lower bounds of variables %v and the upper bounds of variables %v",
+		strings.Join(rowNames, ", "), err.Diagnosis.LowerBoundIDs, err.Diagnosis.UpperBoundIDs,
+	)
+}
+
+/*
+ComputeIIS
+Description:
+
+	Computes an IIS of the current (infeasible) model and maps its members back to the
+	constraints given to AddConstraint() and the IDs of the variables given to AddVariable().
+*/
+func (gs *GurobiSolver) ComputeIIS() (IISDiagnosis, error) {
+	report, err := gs.CurrentModel.ComputeIIS()
+	if err != nil {
+		return IISDiagnosis{}, fmt.Errorf("There was an issue computing the IIS of the current model: %w", err)
+	}
+
+	diagnosis := IISDiagnosis{
+		Constraints:   []ConstraintOrigin{},
+		LowerBoundIDs: []uint64{},
+		UpperBoundIDs: []uint64{},
+		Report:        report,
+	}
+
+	// Map the rows back to constraints
+	for _, member := range report.Constrs {
+		origin, tf := gs.ConstrOrigins[gs.CurrentModel.Constraints[member.Index]]
+		if !tf {
+			return diagnosis, fmt.Errorf("the constraint %v in the IIS was not added through AddConstraint()", member.Name)
+		}
+		diagnosis.Constraints = append(diagnosis.Constraints, origin)
+	}
+
+	for _, member := range report.QConstrs {
+		origin, tf := gs.QConstrOrigins[gs.CurrentModel.QConstraints[member.Index]]
+		if !tf {
+			return diagnosis, fmt.Errorf("the quadratic constraint %v in the IIS was not added through AddConstraint()", member.Name)
+		}
+		diagnosis.Constraints = append(diagnosis.Constraints, origin)
+	}
+
+	// Map the bounds back to variable IDs
+	gurobiIndexToGoopID := make(map[int32]uint64, len(gs.GoopIDToGurobiIndexMap))
+	for goopID, gurobiIndex := range gs.GoopIDToGurobiIndexMap {
+		gurobiIndexToGoopID[gurobiIndex] = goopID
+	}
+
+	for _, member := range report.LowerBounds {
+		goopID, tf := gurobiIndexToGoopID[member.Index]
+		if !tf {
+			return diagnosis, fmt.Errorf("the variable %v in the IIS was not added through AddVariable()", member.Name)
+		}
+		diagnosis.LowerBoundIDs = append(diagnosis.LowerBoundIDs, goopID)
+	}
+
+	for _, member := range report.UpperBounds {
+		goopID, tf := gurobiIndexToGoopID[member.Index]
+		if !tf {
+			return diagnosis, fmt.Errorf("the variable %v in the IIS was not added through AddVariable()", member.Name)
+		}
+		diagnosis.UpperBoundIDs = append(diagnosis.UpperBoundIDs, goopID)
+	}
+
+	return diagnosis, nil
+}
@@ -0,0 +1,226 @@
+package mpgSolver
+
+import (
+	"errors"
+	"fmt"
+
+	gurobi "github.com/MatProGo-dev/Gurobi.go/gurobi"
+	"github.com/MatProGo-dev/Gurobi.go/gurobi/attr"
+	"github.com/MatProGo-dev/MatProInterface.go/optim"
+)
+
+/*
+solution.go
+Description:
+	An extended solution type which adds dual information and solve statistics to optim.Solution.
+*/
+
+// Type Definitions
+
+/*
+ConstraintKey
+Description:
+
+	Identifies a row of the gurobi model by the constraint given to AddConstraint() that created it:
+	Index is the position of that constraint among all constraints given to AddConstraint() (i.e. its
+	position in optim.Model.Constraints when using Solve()) and Element is the element of a
+	VectorConstraint (-1 for scalar constraints).
+*/
+type ConstraintKey struct {
+	Index   int
+	Element int
+}
+
+/*
+ExtendedSolution
+Description:
+
+	An optim.Solution together with the duals, slacks and reduced costs of the solution and the
+	statistics of the solve. Duals and reduced costs are only available for continuous models; for models
+	with quadratic constraints they are only computed when the QCPDual parameter is set. When they are not
+	available, their maps are empty.
+*/
+type ExtendedSolution struct {
+	optim.Solution
+
+	Duals        map[ConstraintKey]float64 // Pi (or QCPi) of each row
+	Slacks       map[ConstraintKey]float64 // Slack (or QCSlack) of each row
+	ReducedCosts map[uint64]float64        // RC of each variable, keyed by the variable's ID
+	Origins      map[ConstraintKey]optim.Constraint
+
+	MIPGap       float64 // Only available for MIPs
+	ObjBound     float64 // Only available for MIPs
+	Runtime      float64
+	IterCount    float64
+	NodeCount    float64 // Only available for MIPs
+	BarIterCount int32
+}
+
+// Functions
+
+/*
+Key
+Description:
+
+	Returns the key of the row(s) created from this origin in an ExtendedSolution.
+*/
+func (origin ConstraintOrigin) Key() ConstraintKey {
+	return ConstraintKey{Index: origin.Index, Element: origin.Element}
+}
+
+/*
+OptimizeExtended
+Description:
+
+	Optimizes the current model like Optimize() and extends the solution with the duals, slacks,
+	reduced costs and statistics of the solve.
+	The statistics are also collected when Optimize() fails (e.g. for an infeasible model or a
+	time limit without an incumbent); the extended solution is then returned with its error.
+*/
+func (gs *GurobiSolver) OptimizeExtended() (ExtendedSolution, error) {
+	sol, optimizeErr := gs.Optimize()
+
+	extended, err := gs.ExtendSolution(sol)
+	if optimizeErr != nil {
+		return extended, optimizeErr
+	}
+
+	return extended, err
+}
+
+/*
+ExtendSolution
+Description:
+
+	Adds the duals, slacks, reduced costs and statistics of the most recent solve of the
+	current model to sol. Values which Gurobi did not compute for this solve (e.g. the slacks
+	of an infeasible model or the MIP gap without an incumbent) are left empty or zero.
+*/
+func (gs *GurobiSolver) ExtendSolution(sol optim.Solution) (ExtendedSolution, error) {
+	// Constants
+	model := gs.CurrentModel
+
+	extended := ExtendedSolution{
+		Solution:     sol,
+		Duals:        make(map[ConstraintKey]float64),
+		Slacks:       make(map[ConstraintKey]float64),
+		ReducedCosts: make(map[uint64]float64),
+		Origins:      make(map[ConstraintKey]optim.Constraint),
+	}
+
+	// Statistics
+	var err error
+	if extended.Runtime, err = gurobi.GetModelAttr(model, attr.Runtime); err != nil {
+		return extended, fmt.Errorf("There was an issue getting the runtime of the current model: %w", err)
+	}
+	if extended.IterCount, err = gurobi.GetModelAttr(model, attr.IterCount); err != nil {
+		return extended, fmt.Errorf("There was an issue getting the iteration count of the current model: %w", err)
+	}
+	if extended.BarIterCount, err = gurobi.GetModelAttr(model, attr.BarIterCount); err != nil {
+		return extended, fmt.Errorf("There was an issue getting the barrier iteration count of the current model: %w", err)
+	}
+
+	isMIP, err := gurobi.GetModelAttr(model, attr.IsMIP)
+	if err != nil {
+		return extended, fmt.Errorf("There was an issue checking if the current model is a MIP: %w", err)
+	}
+
+	if isMIP != 0 {
+		if extended.MIPGap, err = gurobi.GetModelAttr(model, attr.MIPGap); err != nil && !errors.Is(err, gurobi.ErrDataNotAvailable) {
+			return extended, fmt.Errorf("There was an issue getting the MIP gap of the current model: %w", err)
+		}
+		if extended.ObjBound, err = gurobi.GetModelAttr(model, attr.ObjBound); err != nil && !errors.Is(err, gurobi.ErrDataNotAvailable) {
+			return extended, fmt.Errorf("There was an issue getting the objective bound of the current model: %w", err)
+		}
+		if extended.NodeCount, err = gurobi.GetModelAttr(model, attr.NodeCount); err != nil {
+			return extended, fmt.Errorf("There was an issue getting the node count of the current model: %w", err)
+		}
+	}
+
+	// Linear constraints
+	slacks, err := model.GetDoubleAttrArray("Slack", 0, len(model.Constraints))
+	if errors.Is(err, gurobi.ErrDataNotAvailable) {
+		slacks = nil
+	} else if err != nil {
+		return extended, fmt.Errorf("There was an issue getting the slacks of the linear constraints: %w", err)
+	}
+
+	var pis []float64
+	if isMIP == 0 {
+		// Pi is not available without a solution, or for QCPs unless the QCPDual parameter is set.
+		pis, err = model.GetDoubleAttrArray("Pi", 0, len(model.Constraints))
+		if errors.Is(err, gurobi.ErrDataNotAvailable) {
+			pis = nil
+		} else if err != nil {
+			return extended, fmt.Errorf("There was an issue getting the duals of the linear constraints: %w", err)
+		}
+	}
+
+	for i, gurobiConstr := range model.Constraints {
+		origin, tf := gs.ConstrOrigins[gurobiConstr]
+		if !tf {
+			continue
+		}
+		key := origin.Key()
+		extended.Origins[key] = origin.Constraint
+		if slacks != nil {
+			extended.Slacks[key] = slacks[i]
+		}
+		if pis != nil {
+			extended.Duals[key] = pis[i]
+		}
+	}
+
+	// Quadratic constraints
+	qcSlacks, err := model.GetDoubleAttrArray("QCSlack", 0, len(model.QConstraints))
+	if errors.Is(err, gurobi.ErrDataNotAvailable) {
+		qcSlacks = nil
+	} else if err != nil {
+		return extended, fmt.Errorf("There was an issue getting the slacks of the quadratic constraints: %w", err)
+	}
+
+	var qcPis []float64
+	if isMIP == 0 {
+		// QCPi is only computed when the QCPDual parameter is set.
+		qcPis, err = model.GetDoubleAttrArray("QCPi", 0, len(model.QConstraints))
+		if errors.Is(err, gurobi.ErrDataNotAvailable) {
+			qcPis = nil
+		} else if err != nil {
+			return extended, fmt.Errorf("There was an issue getting the duals of the quadratic constraints: %w", err)
+		}
+	}
+
+	for i, gurobiQConstr := range model.QConstraints {
+		origin, tf := gs.QConstrOrigins[gurobiQConstr]
+		if !tf {
+			continue
+		}
+		key := origin.Key()
+		extended.Origins[key] = origin.Constraint
+		if qcSlacks != nil {
+			extended.Slacks[key] = qcSlacks[i]
+		}
+		if qcPis != nil {
+			extended.Duals[key] = qcPis[i]
+		}
+	}
+
+	// Reduced costs
+	if isMIP == 0 {
+		// RC is not available without a solution, or for QCPs unless the QCPDual parameter is set.
+		rcs, err := model.GetDoubleAttrArray("RC", 0, len(model.Variables))
+		if errors.Is(err, gurobi.ErrDataNotAvailable) {
+			rcs = nil
+		} else if err != nil {
+			return extended, fmt.Errorf("There was an issue getting the reduced costs of the variables: %w", err)
+		}
+
+		for goopID, gurobiIndex := range gs.GoopIDToGurobiIndexMap {
+			if int(gurobiIndex) < len(rcs) {
+				extended.ReducedCosts[goopID] = rcs[gurobiIndex]
+			}
+		}
+	}
+
+	return extended, nil
+}
@@ -0,0 +1,280 @@
+package gurobi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+attribute_array_test.go
+Description:
+	Tests the bulk array and list attribute functions of the gurobi package.
+*/
+
+/*
+TestAttributeArray_GetDoubleAttrArray1
+Description:
+
+	Verifies that GetDoubleAttrArray() returns an error when the model is not initialized.
+*/
+func TestAttributeArray_GetDoubleAttrArray1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	_, err := model0.GetDoubleAttrArray("X", 0, 1)
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestAttributeArray_GetDoubleAttrArray2
+Description:
+
+	Adds 100 variables with x_i fixed to i, optimizes and reads back the X value of
+	every variable with a single call to GetDoubleAttrArray().
+*/
+func TestAttributeArray_GetDoubleAttrArray2(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "attributearray-getdoubleattrarray2")
+	n := 100
+
+	for i := 0; i < n; i++ {
+		_, err := model.AddVar(gurobi.CONTINUOUS, 1.0, float64(i), float64(i), fmt.Sprintf("x%v", i), []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Fatalf("unexpected error adding variable %v: %v", i, err)
+		}
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	// Test
+	xs, err := model.GetDoubleAttrArray("X", 0, n)
+	if err != nil {
+		t.Fatalf("unexpected error retrieving X: %v", err)
+	}
+
+	if len(xs) != n {
+		t.Fatalf("expected %v values; received %v", n, len(xs))
+	}
+
+	for i, x := range xs {
+		if x != float64(i) {
+			t.Errorf("expected x%v = %v; received %v", i, float64(i), x)
+		}
+	}
+}
+
+/*
+TestAttributeArray_SetDoubleAttrArray1
+Description:
+
+	Sets the upper bounds of a range of variables and reads them back through
+	both the array and the list functions.
+*/
+func TestAttributeArray_SetDoubleAttrArray1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "attributearray-setdoubleattrarray1")
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		if _, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 10.0, name, []*gurobi.Constr{}, []float64{}); err != nil {
+			t.Fatalf("unexpected error adding variable %v: %v", name, err)
+		}
+	}
+
+	// Test
+	if err := model.SetDoubleAttrArray("UB", 1, []float64{2.0, 3.0}); err != nil {
+		t.Fatalf("unexpected error setting UB: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Fatalf("unexpected error updating the model: %v", err)
+	}
+
+	ubs, err := model.GetDoubleAttrArray("UB", 0, 4)
+	if err != nil {
+		t.Fatalf("unexpected error retrieving UB: %v", err)
+	}
+
+	expected := []float64{10, 2, 3, 10}
+	for i := range expected {
+		if ubs[i] != expected[i] {
+			t.Errorf("expected UB[%v] = %v; received %v", i, expected[i], ubs[i])
+		}
+	}
+
+	ubs, err = model.GetDoubleAttrList("UB", []int32{2, 0})
+	if err != nil {
+		t.Fatalf("unexpected error retrieving UB: %v", err)
+	}
+
+	if ubs[0] != 3 || ubs[1] != 10 {
+		t.Errorf("expected UB list [3 10]; received %v", ubs)
+	}
+}
+
+/*
+TestAttributeArray_SetCharAttrVars1
+Description:
+
+	Changes the type of a subset of the variables and verifies it through GetCharAttrArray().
+*/
+func TestAttributeArray_SetCharAttrVars1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "attributearray-setcharattrvars1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 10.0, "y", []*gurobi.Constr{}, []float64{})
+	z, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 10.0, "z", []*gurobi.Constr{}, []float64{})
+
+	// Test
+	if err := model.SetCharAttrVars("VType", []*gurobi.Var{z, x}, []int8{gurobi.INTEGER, gurobi.BINARY}); err != nil {
+		t.Fatalf("unexpected error setting VType: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Fatalf("unexpected error updating the model: %v", err)
+	}
+
+	vtypes, err := model.GetCharAttrArray("VType", 0, 3)
+	if err != nil {
+		t.Fatalf("unexpected error retrieving VType: %v", err)
+	}
+
+	expected := []int8{gurobi.BINARY, gurobi.CONTINUOUS, gurobi.INTEGER}
+	for i := range expected {
+		if vtypes[i] != expected[i] {
+			t.Errorf("expected VType[%v] = %v; received %v", i, expected[i], vtypes[i])
+		}
+	}
+
+	vtypes, err = model.GetCharAttrVars("VType", []*gurobi.Var{y})
+	if err != nil {
+		t.Fatalf("unexpected error retrieving VType: %v", err)
+	}
+
+	if vtypes[0] != gurobi.CONTINUOUS {
+		t.Errorf("expected VType of y to be %v; received %v", gurobi.CONTINUOUS, vtypes[0])
+	}
+}
+
+/*
+TestAttributeArray_SetStringAttrArray1
+Description:
+
+	Renames all variables with SetStringAttrArray() and reads the names back.
+*/
+func TestAttributeArray_SetStringAttrArray1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "attributearray-setstringattrarray1")
+
+	model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+
+	// Test
+	names := []string{"first", "second"}
+	if err := model.SetStringAttrArray("VarName", 0, names); err != nil {
+		t.Fatalf("unexpected error setting VarName: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Fatalf("unexpected error updating the model: %v", err)
+	}
+
+	received, err := model.GetStringAttrArray("VarName", 0, len(names))
+	if err != nil {
+		t.Fatalf("unexpected error retrieving VarName: %v", err)
+	}
+
+	for i := range names {
+		if received[i] != names[i] {
+			t.Errorf("expected VarName[%v] = %v; received %v", i, names[i], received[i])
+		}
+	}
+}
+
+/*
+TestAttributeArray_SetIntAttrConstrs1
+Description:
+
+	Marks a constraint as lazy with SetIntAttrConstrs() and reads the flag back.
+*/
+func TestAttributeArray_SetIntAttrConstrs1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "attributearray-setintattrconstrs1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	c1, _ := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseGreaterThan, 1.0, "c1")
+	c2, _ := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 5.0, "c2")
+
+	// Test
+	if err := model.SetIntAttrConstrs("Lazy", []*gurobi.Constr{c2}, []int32{1}); err != nil {
+		t.Fatalf("unexpected error setting Lazy: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Fatalf("unexpected error updating the model: %v", err)
+	}
+
+	lazy, err := model.GetIntAttrConstrs("Lazy", []*gurobi.Constr{c1, c2})
+	if err != nil {
+		t.Fatalf("unexpected error retrieving Lazy: %v", err)
+	}
+
+	if lazy[0] != 0 || lazy[1] != 1 {
+		t.Errorf("expected Lazy [0 1]; received %v", lazy)
+	}
+}
+
+/*
+TestAttributeArray_SetDoubleAttrList1
+Description:
+
+	Verifies that SetDoubleAttrList() returns an error when the number of indices
+	and values do not match.
+*/
+func TestAttributeArray_SetDoubleAttrList1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "attributearray-setdoubleattrlist1")
+
+	// Algorithm
+	err := model.SetDoubleAttrList("UB", []int32{0, 1}, []float64{1.0})
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != (gurobi.MismatchedLengthError{
+			Length1: 2,
+			Length2: 1,
+			Name1:   "ind",
+			Name2:   "values",
+		}).Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestAttributeArray_GetDoubleAttrConstrs1
+Description:
+
+	Verifies that GetDoubleAttrConstrs() returns an error when given a deleted constraint.
+*/
+func TestAttributeArray_GetDoubleAttrConstrs1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "attributearray-getdoubleattrconstrs1")
+
+	// Algorithm
+	_, err := model.GetDoubleAttrConstrs("RHS", []*gurobi.Constr{{Model: model, Index: -1}})
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != "invalid constraint given at position 0" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
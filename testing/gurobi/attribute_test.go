@@ -0,0 +1,258 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+	"github.com/MatProGo-dev/Gurobi.go/gurobi/attr"
+)
+
+/*
+attribute_test.go
+Description:
+	Tests the typed attribute descriptors and their generic accessors.
+*/
+
+// Read-only attributes must have ReadOnly descriptors so that they cannot be given to a setter.
+var (
+	_ gurobi.ReadOnlyVarDoubleAttr     = attr.X
+	_ gurobi.ReadOnlyModelIntAttr      = attr.NumVars
+	_ gurobi.ReadOnlyGenConstrIntAttr  = attr.GenConstrType
+	_ gurobi.ReadOnlySOSIntAttr        = attr.IISSOS
+	_ gurobi.VarDoubleAttr             = attr.Obj
+	_ gurobi.GenConstrDoubleAttr       = attr.FuncPieceError
+	_ gurobi.SOSIntAttr                = attr.IISSOSForce
+	_ gurobi.ReadOnlyQConstrDoubleAttr = attr.QCPi
+	_ gurobi.ReadOnlyConstrDoubleAttr  = attr.Pi
+	_ gurobi.ReadOnlyModelDoubleAttr   = attr.ObjNVal
+)
+
+/*
+TestAttr_Lookup1
+Description:
+
+	Verifies a few entries of the generated attribute catalog and that
+	every attribute appears in it only once.
+*/
+func TestAttr_Lookup1(t *testing.T) {
+	// Constants
+	expected := []attr.Info{
+		{Name: "X", Object: attr.ObjectVar, Type: attr.TypeDouble, Settable: false},
+		{Name: "VType", Object: attr.ObjectVar, Type: attr.TypeChar, Settable: true},
+		{Name: "NumVars", Object: attr.ObjectModel, Type: attr.TypeInt, Settable: false},
+		{Name: "RHS", Object: attr.ObjectConstr, Type: attr.TypeDouble, Settable: true},
+		{Name: "QCName", Object: attr.ObjectQConstr, Type: attr.TypeString, Settable: true},
+		{Name: "GenConstrType", Object: attr.ObjectGenConstr, Type: attr.TypeInt, Settable: false},
+		{Name: "FuncPieceError", Object: attr.ObjectGenConstr, Type: attr.TypeDouble, Settable: true},
+		{Name: "IISSOSForce", Object: attr.ObjectSOS, Type: attr.TypeInt, Settable: true},
+		{Name: "ConstrVio", Object: attr.ObjectModel, Type: attr.TypeDouble, Settable: false},
+		{Name: "ObjNPriority", Object: attr.ObjectModel, Type: attr.TypeInt, Settable: true},
+	}
+
+	// Test
+	for _, e := range expected {
+		info, ok := attr.Lookup(e.Name)
+		if !ok {
+			t.Errorf("expected %v to be in the catalog", e.Name)
+			continue
+		}
+		if info.Object != e.Object || info.Type != e.Type || info.Settable != e.Settable {
+			t.Errorf("unexpected catalog entry for %v: %+v", e.Name, info)
+		}
+	}
+
+	if _, ok := attr.Lookup("NotAGurobiAttribute"); ok {
+		t.Errorf("did not expect NotAGurobiAttribute to be in the catalog")
+	}
+
+	seen := make(map[string]bool)
+	for _, info := range attr.Catalog {
+		if seen[info.Name] {
+			t.Errorf("attribute %v appears more than once in the catalog", info.Name)
+		}
+		seen[info.Name] = true
+	}
+
+	if attr.X.Name != "X" || attr.NumVars.Name != "NumVars" {
+		t.Errorf("unexpected descriptor names %v and %v", attr.X.Name, attr.NumVars.Name)
+	}
+}
+
+/*
+TestAttr_GetModelAttr1
+Description:
+
+	Verifies that GetModelAttr() returns an error when the model is not initialized.
+*/
+func TestAttr_GetModelAttr1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	_, err := gurobi.GetModelAttr(model0, attr.NumVars)
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestAttr_GetVarAttr1
+Description:
+
+	Sets model, variable and constraint attributes through the typed descriptors, solves
+	max x + 2y subject to x + y <= 1 and reads the solution back through them.
+*/
+func TestAttr_GetVarAttr1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "testattr-getvarattr1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	c, err := model.AddConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, gurobi.SenseLessThan, 2.0, "c0")
+	if err != nil {
+		t.Errorf("unexpected error adding constraint: %v", err)
+	}
+
+	// Algorithm
+	if err := gurobi.SetModelAttr(model, attr.ModelSense, int32(gurobi.MAXIMIZE)); err != nil {
+		t.Errorf("unexpected error setting ModelSense: %v", err)
+	}
+	if err := gurobi.SetVarAttr(x, attr.Obj, 1.0); err != nil {
+		t.Errorf("unexpected error setting Obj: %v", err)
+	}
+	if err := gurobi.SetVarAttr(y, attr.Obj, 2.0); err != nil {
+		t.Errorf("unexpected error setting Obj: %v", err)
+	}
+	if err := gurobi.SetConstrAttr(c, attr.RHS, 1.0); err != nil {
+		t.Errorf("unexpected error setting RHS: %v", err)
+	}
+
+	objVal := optimizeAndGetObjective(t, model)
+
+	// Test
+	if objVal != 2.0 {
+		t.Errorf("expected objective %v; received %v", 2.0, objVal)
+	}
+
+	numVars, err := gurobi.GetModelAttr(model, attr.NumVars)
+	if err != nil {
+		t.Errorf("unexpected error getting NumVars: %v", err)
+	}
+	if numVars != 2 {
+		t.Errorf("expected 2 variables; received %v", numVars)
+	}
+
+	yVal, err := gurobi.GetVarAttr(y, attr.X)
+	if err != nil {
+		t.Errorf("unexpected error getting X: %v", err)
+	}
+	if yVal != 1.0 {
+		t.Errorf("expected y to be 1; received %v", yVal)
+	}
+
+	vtype, err := gurobi.GetVarAttr(x, attr.VType)
+	if err != nil {
+		t.Errorf("unexpected error getting VType: %v", err)
+	}
+	if vtype != gurobi.CONTINUOUS {
+		t.Errorf("expected x to be continuous; received %v", vtype)
+	}
+
+	name, err := gurobi.GetConstrAttr(c, attr.ConstrName)
+	if err != nil {
+		t.Errorf("unexpected error getting ConstrName: %v", err)
+	}
+	if name != "c0" {
+		t.Errorf("expected the constraint to be named c0; received %v", name)
+	}
+}
+
+/*
+TestAttr_GetGenConstrAttr1
+Description:
+
+	Sets and reads the attributes of a function constraint through the typed descriptors.
+*/
+func TestAttr_GetGenConstrAttr1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "testattr-getgenconstrattr1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+	gc, err := model.AddGenConstrExp(x, y, "y_exp", nil)
+	if err != nil {
+		t.Fatalf("unexpected error adding exp constraint: %v", err)
+	}
+
+	// Algorithm
+	if err := gurobi.SetGenConstrAttr(gc, attr.FuncPieceError, 1e-4); err != nil {
+		t.Errorf("unexpected error setting FuncPieceError: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Errorf("unexpected error updating the model: %v", err)
+	}
+
+	// Test
+	gcType, err := gurobi.GetGenConstrAttr(gc, attr.GenConstrType)
+	if err != nil {
+		t.Errorf("unexpected error getting GenConstrType: %v", err)
+	}
+	if gcType != gurobi.GENCONSTR_EXP {
+		t.Errorf("expected GenConstrType %v; received %v", gurobi.GENCONSTR_EXP, gcType)
+	}
+
+	pieceError, err := gurobi.GetGenConstrAttr(gc, attr.FuncPieceError)
+	if err != nil {
+		t.Errorf("unexpected error getting FuncPieceError: %v", err)
+	}
+	if pieceError != 1e-4 {
+		t.Errorf("expected FuncPieceError %v; received %v", 1e-4, pieceError)
+	}
+
+	name, err := gurobi.GetGenConstrAttr(gc, attr.GenConstrName)
+	if err != nil {
+		t.Errorf("unexpected error getting GenConstrName: %v", err)
+	}
+	if name != "y_exp" {
+		t.Errorf("expected the general constraint to be named y_exp; received %v", name)
+	}
+}
+
+/*
+TestAttr_GetSOSAttr1
+Description:
+
+	Sets and reads the IISSOSForce attribute of an SOS constraint through the typed descriptors.
+*/
+func TestAttr_GetSOSAttr1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "testattr-getsosattr1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	sos, err := model.AddSOS(gurobi.SOS_TYPE1, []*gurobi.Var{x, y}, []float64{1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error adding the SOS constraint: %v", err)
+	}
+
+	// Algorithm
+	if err := gurobi.SetSOSAttr(sos, attr.IISSOSForce, int32(1)); err != nil {
+		t.Errorf("unexpected error setting IISSOSForce: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Errorf("unexpected error updating the model: %v", err)
+	}
+
+	// Test
+	force, err := gurobi.GetSOSAttr(sos, attr.IISSOSForce)
+	if err != nil {
+		t.Errorf("unexpected error getting IISSOSForce: %v", err)
+	}
+	if force != 1 {
+		t.Errorf("expected IISSOSForce 1; received %v", force)
+	}
+}
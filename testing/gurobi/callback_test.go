@@ -0,0 +1,322 @@
+package gurobi_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+callback_test.go
+Description:
+	Tests the callback bridge between Gurobi and Go.
+*/
+
+/*
+createKnapsackModel
+Description:
+
+	Creates a small knapsack problem (maximize value with a weight limit) which
+	needs the MIP solver and returns the model with its binary variables.
+*/
+func createKnapsackModel(t *testing.T, name string) (*gurobi.Env, *gurobi.Model, []*gurobi.Var) {
+	env, err := gurobi.NewEnv(name + ".log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env: %v", err)
+	}
+
+	model, err := gurobi.NewModel(name, env)
+	if err != nil {
+		t.Fatalf("There was an issue creating the new model: %v", err)
+	}
+
+	values := []float64{10, 13, 7, 8, 4}
+	weights := []float64{5, 7, 4, 4, 2}
+
+	vars := make([]*gurobi.Var, len(values))
+	for i := range values {
+		vars[i], err = model.AddVar(gurobi.BINARY, 0.0, 0.0, 1.0, "", []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Fatalf("There was an issue adding a variable: %v", err)
+		}
+	}
+
+	expr := gurobi.LinExpr{}
+	for i, v := range vars {
+		expr.AddTerm(v, values[i])
+	}
+	if err := model.SetObjective(&expr, gurobi.MAXIMIZE); err != nil {
+		t.Fatalf("There was an issue setting the objective: %v", err)
+	}
+
+	if _, err := model.AddConstr(vars, weights, gurobi.SenseLessThan, 12.0, "capacity"); err != nil {
+		t.Fatalf("There was an issue adding the capacity constraint: %v", err)
+	}
+
+	return env, model, vars
+}
+
+/*
+TestCallback_SetCallback1
+Description:
+
+	Verifies that SetCallback() returns an error when the model is not initialized.
+*/
+func TestCallback_SetCallback1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	err := model0.SetCallback(func(ctx *gurobi.CallbackContext) error { return nil })
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestCallback_SetCallback2
+Description:
+
+	Verifies that a callback sees each new incumbent of a small MIP and that
+	the last incumbent it sees matches the final objective value.
+*/
+func TestCallback_SetCallback2(t *testing.T) {
+	// Constants
+	testName := "callback-setcallback2"
+	env, model, vars := createKnapsackModel(t, testName)
+	defer os.Remove(testName + ".log")
+	defer env.Free()
+	defer model.Free()
+
+	// Register callback
+	incumbents := []float64{}
+	err := model.SetCallback(func(ctx *gurobi.CallbackContext) error {
+		if ctx.Where != gurobi.CB_MIPSOL {
+			return nil
+		}
+
+		obj, err := ctx.MIPSolObjective()
+		if err != nil {
+			return err
+		}
+
+		sol, err := ctx.MIPSolValues(vars)
+		if err != nil {
+			return err
+		}
+		if len(sol) != len(vars) {
+			t.Errorf("expected %v values in the MIPSOL solution; received %v", len(vars), len(sol))
+		}
+
+		incumbents = append(incumbents, obj)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("unexpected error setting callback: %v", err)
+	}
+
+	// Optimize
+	if err := model.Optimize(); err != nil {
+		t.Errorf("unexpected error while optimizing: %v", err)
+	}
+
+	objVal, err := model.GetDoubleAttr(gurobi.DBL_ATTR_OBJVAL)
+	if err != nil {
+		t.Errorf("unexpected error retrieving ObjVal: %v", err)
+	}
+
+	// Checks
+	if len(incumbents) == 0 {
+		t.Errorf("expected the callback to observe at least one incumbent; received none")
+	} else {
+		if best := incumbents[len(incumbents)-1]; best != objVal {
+			t.Errorf("the last incumbent (%v) does not match the objective value (%v)", best, objVal)
+		}
+	}
+}
+
+/*
+TestCallback_SetCallback3
+Description:
+
+	Verifies that an error returned by the callback stops the optimization
+	and is returned by Optimize().
+*/
+func TestCallback_SetCallback3(t *testing.T) {
+	// Constants
+	testName := "callback-setcallback3"
+	env, model, _ := createKnapsackModel(t, testName)
+	defer os.Remove(testName + ".log")
+	defer env.Free()
+	defer model.Free()
+
+	stopErr := errors.New("stop right there")
+
+	// Register callback
+	err := model.SetCallback(func(ctx *gurobi.CallbackContext) error {
+		return stopErr
+	})
+	if err != nil {
+		t.Errorf("unexpected error setting callback: %v", err)
+	}
+
+	// Optimize
+	err = model.Optimize()
+	if err == nil {
+		t.Errorf("expected Optimize() to return an error, but received none!")
+	} else {
+		if err != stopErr {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestCallback_SetCallback4
+Description:
+
+	Verifies that a callback which calls Terminate() stops the
+	optimization with an INTERRUPTED status.
+*/
+func TestCallback_SetCallback4(t *testing.T) {
+	// Constants
+	testName := "callback-setcallback4"
+	env, model, _ := createKnapsackModel(t, testName)
+	defer os.Remove(testName + ".log")
+	defer env.Free()
+	defer model.Free()
+
+	// Register callback
+	err := model.SetCallback(func(ctx *gurobi.CallbackContext) error {
+		if ctx.Where == gurobi.CB_MESSAGE {
+			ctx.Terminate()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("unexpected error setting callback: %v", err)
+	}
+
+	// Optimize
+	if err := model.Optimize(); err != nil {
+		t.Errorf("unexpected error while optimizing: %v", err)
+	}
+
+	status, err := model.GetIntAttr(gurobi.INT_ATTR_STATUS)
+	if err != nil {
+		t.Errorf("unexpected error retrieving Status: %v", err)
+	}
+
+	if status != gurobi.INTERRUPTED {
+		t.Errorf("expected status %v; received %v", gurobi.INTERRUPTED, status)
+	}
+}
+
+/*
+TestCallback_AddLazy1
+Description:
+
+	Verifies that a lazy constraint added from a MIPSOL callback cuts off an
+	incumbent which violates it. Without the lazy constraint, both binaries
+	would be set to 1.
+*/
+func TestCallback_AddLazy1(t *testing.T) {
+	// Constants
+	testName := "callback-addlazy1"
+	env, err := gurobi.NewEnv(testName + ".log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env: %v", err)
+	}
+	defer os.Remove(testName + ".log")
+	defer env.Free()
+
+	model, err := gurobi.NewModel(testName, env)
+	if err != nil {
+		t.Fatalf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	// Lazy constraints must be enabled on the model's environment.
+	if err := model.Env.SetIntParam("LazyConstraints", 1); err != nil {
+		t.Errorf("unexpected error enabling lazy constraints: %v", err)
+	}
+
+	x, err := model.AddVar(gurobi.BINARY, 1.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.BINARY, 1.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+	if err := model.SetIntAttr("ModelSense", gurobi.MAXIMIZE); err != nil {
+		t.Errorf("There was an issue setting the model sense: %v", err)
+	}
+
+	// Register callback
+	err = model.SetCallback(func(ctx *gurobi.CallbackContext) error {
+		if ctx.Where != gurobi.CB_MIPSOL {
+			return nil
+		}
+
+		sol, err := ctx.MIPSolValues([]*gurobi.Var{x, y})
+		if err != nil {
+			return err
+		}
+
+		if sol[0]+sol[1] > 1.5 {
+			return ctx.AddLazy([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, gurobi.SenseLessThan, 1.0)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("unexpected error setting callback: %v", err)
+	}
+
+	// Optimize
+	if err := model.Optimize(); err != nil {
+		t.Errorf("unexpected error while optimizing: %v", err)
+	}
+
+	objVal, err := model.GetDoubleAttr(gurobi.DBL_ATTR_OBJVAL)
+	if err != nil {
+		t.Errorf("unexpected error retrieving ObjVal: %v", err)
+	}
+
+	if objVal != 1.0 {
+		t.Errorf("expected the lazy constraint to limit the objective to 1; received %v", objVal)
+	}
+}
+
+/*
+TestCallback_AddLazy2
+Description:
+
+	Verifies that AddLazy() returns an error when the number of variables
+	and coefficients do not match.
+*/
+func TestCallback_AddLazy2(t *testing.T) {
+	// Constants
+	ctx := gurobi.CallbackContext{}
+
+	// Algorithm
+	err := ctx.AddLazy([]*gurobi.Var{}, []float64{1.0}, gurobi.SenseLessThan, 1.0)
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != (gurobi.MismatchedLengthError{
+			Length1: 0,
+			Length2: 1,
+			Name1:   "vars",
+			Name2:   "vals",
+		}).Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
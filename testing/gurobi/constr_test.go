@@ -0,0 +1,197 @@
+package gurobi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+constr_test.go
+Description:
+	Tests the Constr accessors and the name lookup functions of the gurobi package.
+*/
+
+/*
+TestConstr_Pi1
+Description:
+
+	Minimizes x + 2y subject to
+		c1: x + y >= 2
+		c2: x <= 5
+	with x, y >= 0. At the optimum x = 2, y = 0, so c1 is tight with
+	dual value 1 and c2 has a slack of 3.
+*/
+func TestConstr_Pi1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "constr-pi1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 2.0, 0.0, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+
+	c1, err := model.AddConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, gurobi.SenseGreaterThan, 2.0, "c1")
+	if err != nil {
+		t.Fatalf("unexpected error adding c1: %v", err)
+	}
+	c2, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 5.0, "c2")
+	if err != nil {
+		t.Fatalf("unexpected error adding c2: %v", err)
+	}
+
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-2.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 2.0, objVal)
+	}
+
+	// Test
+	pi, err := c1.Pi()
+	if err != nil {
+		t.Errorf("unexpected error retrieving Pi: %v", err)
+	}
+	if math.Abs(pi-1.0) > 1e-6 {
+		t.Errorf("expected Pi of c1 to be %v; received %v", 1.0, pi)
+	}
+
+	slack, err := c2.Slack()
+	if err != nil {
+		t.Errorf("unexpected error retrieving Slack: %v", err)
+	}
+	if math.Abs(slack-3.0) > 1e-6 {
+		t.Errorf("expected Slack of c2 to be %v; received %v", 3.0, slack)
+	}
+
+	cbasis, err := c2.CBasis()
+	if err != nil {
+		t.Errorf("unexpected error retrieving CBasis: %v", err)
+	}
+	if cbasis != gurobi.BASIC {
+		t.Errorf("expected CBasis of c2 to be %v; received %v", gurobi.BASIC, cbasis)
+	}
+}
+
+/*
+TestConstr_RHS1
+Description:
+
+	Verifies that RHS(), Sense(), ConstrName() and Lazy() reflect the values
+	given when the constraint was created and after changing them.
+*/
+func TestConstr_RHS1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "constr-rhs1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	c, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 4.0, "cap")
+	if err != nil {
+		t.Fatalf("unexpected error adding constraint: %v", err)
+	}
+
+	// Test
+	if rhs, err := c.RHS(); err != nil || rhs != 4.0 {
+		t.Errorf("expected RHS %v; received %v (err = %v)", 4.0, rhs, err)
+	}
+
+	if sense, err := c.Sense(); err != nil || sense != gurobi.SenseLessThan {
+		t.Errorf("expected Sense %v; received %v (err = %v)", gurobi.SenseLessThan, sense, err)
+	}
+
+	if name, err := c.ConstrName(); err != nil || name != "cap" {
+		t.Errorf("expected ConstrName %v; received %v (err = %v)", "cap", name, err)
+	}
+
+	if err := c.SetDouble("RHS", 6.0); err != nil {
+		t.Errorf("unexpected error setting RHS: %v", err)
+	}
+	if err := c.SetInt("Lazy", 1); err != nil {
+		t.Errorf("unexpected error setting Lazy: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Fatalf("unexpected error updating the model: %v", err)
+	}
+
+	if rhs, err := c.RHS(); err != nil || rhs != 6.0 {
+		t.Errorf("expected RHS %v; received %v (err = %v)", 6.0, rhs, err)
+	}
+
+	if lazy, err := c.Lazy(); err != nil || lazy != 1 {
+		t.Errorf("expected Lazy %v; received %v (err = %v)", 1, lazy, err)
+	}
+}
+
+/*
+TestModel_GetVarByName1
+Description:
+
+	Verifies that GetVarByName() returns an error when the model is not initialized.
+*/
+func TestModel_GetVarByName1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	_, err := model0.GetVarByName("x")
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestModel_GetVarByName2
+Description:
+
+	Verifies that GetVarByName() returns the same handle that AddVar() returned
+	and an error for an unknown name.
+*/
+func TestModel_GetVarByName2(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "model-getvarbyname2")
+
+	model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+
+	// Test
+	found, err := model.GetVarByName("y")
+	if err != nil {
+		t.Errorf("unexpected error looking up y: %v", err)
+	}
+	if found != y {
+		t.Errorf("expected GetVarByName to return the handle of y (index %v); received %v", y.Index, found)
+	}
+
+	if _, err := model.GetVarByName("z"); err == nil {
+		t.Errorf("expected an error for an unknown variable, but received none!")
+	}
+}
+
+/*
+TestModel_GetConstrByName1
+Description:
+
+	Verifies that GetConstrByName() returns the same handle that AddConstr() returned
+	and an error for an unknown name.
+*/
+func TestModel_GetConstrByName1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "model-getconstrbyname1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 1.0, "c0")
+	c1, _ := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseGreaterThan, 0.0, "c1")
+
+	// Test
+	found, err := model.GetConstrByName("c1")
+	if err != nil {
+		t.Errorf("unexpected error looking up c1: %v", err)
+	}
+	if found != c1 {
+		t.Errorf("expected GetConstrByName to return the handle of c1 (index %v); received %v", c1.Index, found)
+	}
+
+	if _, err := model.GetConstrByName("missing"); err == nil {
+		t.Errorf("expected an error for an unknown constraint, but received none!")
+	}
+}
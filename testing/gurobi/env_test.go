@@ -1,7 +1,9 @@
 package gurobi_test
 
 import (
+	"errors"
 	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+	"os"
 	"testing"
 )
 
@@ -80,6 +82,31 @@ func TestEnv_SetTimeLimit2(t *testing.T) {
 	}
 }
 
+/*
+TestEnv_SetTimeLimit3
+Description:
+
+	Tests that the error for a negative time limit keeps Gurobi's error code,
+	so that it matches ErrValueOutOfRange.
+*/
+func TestEnv_SetTimeLimit3(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("setTimeLimit3.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer os.Remove("setTimeLimit3.log")
+	defer env.Free()
+
+	// Algorithm
+	err = env.SetTimeLimit(-1.0)
+	if err == nil {
+		t.Errorf("expected an error to be thrown, but received none!")
+	} else if !errors.Is(err, gurobi.ErrValueOutOfRange) {
+		t.Errorf("expected the error to match ErrValueOutOfRange; received %v", err)
+	}
+}
+
 /*
 TestEnv_SetDBLParam1
 Description:
@@ -214,3 +241,257 @@ func TestEnv_GetTimeLimit1(t *testing.T) {
 		}
 	}
 }
+
+/*
+TestEnv_SetIntParam1
+Description:
+
+	Verifies that we can set and read back the integer parameter Threads.
+*/
+func TestEnv_SetIntParam1(t *testing.T) {
+	// Constants
+	logfilename1 := "thomTide.log"
+	var newThreads int32 = 2
+
+	// Algorithm
+	env, err := gurobi.NewEnv(logfilename1)
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+
+	err = env.SetIntParam("Threads", newThreads)
+	if err != nil {
+		t.Errorf("There was an error setting Threads: %v", err)
+	}
+
+	detectedThreads, err := env.GetIntParam("Threads")
+	if err != nil {
+		t.Errorf("There was an error getting Threads: %v", err)
+	}
+
+	if detectedThreads != newThreads {
+		t.Errorf("The detected Threads (%v) was not equal to the expected value (%v).", detectedThreads, newThreads)
+	}
+}
+
+/*
+TestEnv_SetIntParam2
+Description:
+
+	Verifies that SetIntParam() rejects a double parameter.
+*/
+func TestEnv_SetIntParam2(t *testing.T) {
+	// Constants
+	logfilename1 := "thomTide.log"
+
+	// Algorithm
+	env, err := gurobi.NewEnv(logfilename1)
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+
+	err = env.SetIntParam("TimeLimit", 10)
+	if err == nil {
+		t.Errorf("expected an error when setting a double parameter as an integer, but received none!")
+	}
+}
+
+/*
+TestEnv_GetIntParam1
+Description:
+
+	Verifies that GetIntParam() returns an error when the env is uninitialized.
+*/
+func TestEnv_GetIntParam1(t *testing.T) {
+	// Constants
+	var env0 *gurobi.Env
+
+	// Algorithm
+	_, err := env0.GetIntParam("Threads")
+	if err == nil {
+		t.Errorf("expected an error to be thrown, but received none!")
+	} else {
+		if err.Error() != env0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestEnv_SetStrParam1
+Description:
+
+	Verifies that we can set and read back the string parameter LogFile.
+*/
+func TestEnv_SetStrParam1(t *testing.T) {
+	// Constants
+	logfilename1 := "thomTide.log"
+	newLogFile := "thomTide2.log"
+
+	// Algorithm
+	env, err := gurobi.NewEnv(logfilename1)
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove(newLogFile)
+
+	err = env.SetStrParam("LogFile", newLogFile)
+	if err != nil {
+		t.Errorf("There was an error setting LogFile: %v", err)
+	}
+
+	detectedLogFile, err := env.GetStrParam("LogFile")
+	if err != nil {
+		t.Errorf("There was an error getting LogFile: %v", err)
+	}
+
+	if detectedLogFile != newLogFile {
+		t.Errorf("The detected LogFile (%v) was not equal to the expected value (%v).", detectedLogFile, newLogFile)
+	}
+}
+
+/*
+TestEnv_SetParam1
+Description:
+
+	Verifies that SetParam() can set integer and double parameters from strings.
+*/
+func TestEnv_SetParam1(t *testing.T) {
+	// Constants
+	logfilename1 := "thomTide.log"
+
+	// Algorithm
+	env, err := gurobi.NewEnv(logfilename1)
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+
+	if err = env.SetParam("MIPFocus", "2"); err != nil {
+		t.Errorf("There was an error setting MIPFocus: %v", err)
+	}
+
+	if err = env.SetParam("TimeLimit", "25.5"); err != nil {
+		t.Errorf("There was an error setting TimeLimit: %v", err)
+	}
+
+	mipFocus, err := env.GetIntParam("MIPFocus")
+	if err != nil {
+		t.Errorf("There was an error getting MIPFocus: %v", err)
+	}
+
+	if mipFocus != 2 {
+		t.Errorf("The detected MIPFocus (%v) was not equal to the expected value (%v).", mipFocus, 2)
+	}
+
+	timeLimit, err := env.GetDBLParam("TimeLimit")
+	if err != nil {
+		t.Errorf("There was an error getting TimeLimit: %v", err)
+	}
+
+	if timeLimit != 25.5 {
+		t.Errorf("The detected TimeLimit (%v) was not equal to the expected value (%v).", timeLimit, 25.5)
+	}
+}
+
+/*
+TestEnv_SetParam2
+Description:
+
+	Verifies that SetParam() returns an error for a parameter that Gurobi does not know.
+*/
+func TestEnv_SetParam2(t *testing.T) {
+	// Constants
+	logfilename1 := "thomTide.log"
+
+	// Algorithm
+	env, err := gurobi.NewEnv(logfilename1)
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+
+	if err = env.SetParam("NotAGurobiParam", "1"); err == nil {
+		t.Errorf("expected an error when setting an unknown parameter, but received none!")
+	}
+
+	if env.IsValidIntParam("NotAGurobiParam") {
+		t.Errorf("expected NotAGurobiParam to be an invalid parameter")
+	}
+}
+
+/*
+TestIsValidDBLParam1
+Description:
+
+	Verifies that the package-level IsValidDBLParam() still accepts the double
+	parameters it supported before and rejects other names.
+*/
+func TestIsValidDBLParam1(t *testing.T) {
+	for _, paramName := range []string{"TimeLimit", "Cutoff", "BestObjStop"} {
+		if !gurobi.IsValidDBLParam(paramName) {
+			t.Errorf("expected %v to be a valid double parameter", paramName)
+		}
+	}
+
+	if gurobi.IsValidDBLParam("NotAGurobiParam") {
+		t.Errorf("expected NotAGurobiParam to be an invalid double parameter")
+	}
+}
+
+/*
+TestEnv_GetIntParamInfo1
+Description:
+
+	Verifies that the bounds and default of the Method parameter are read from the library.
+*/
+func TestEnv_GetIntParamInfo1(t *testing.T) {
+	// Constants
+	logfilename1 := "thomTide.log"
+
+	// Algorithm
+	env, err := gurobi.NewEnv(logfilename1)
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+
+	info, err := env.GetIntParamInfo("Method")
+	if err != nil {
+		t.Errorf("There was an error getting the info of Method: %v", err)
+	}
+
+	if info.Default != -1 || info.Min != -1 || info.Value != info.Default {
+		t.Errorf("unexpected info for Method: %+v", info)
+	}
+}
+
+/*
+TestEnv_GetDBLParamInfo1
+Description:
+
+	Verifies that the bounds and default of the TimeLimit parameter are read from the library.
+*/
+func TestEnv_GetDBLParamInfo1(t *testing.T) {
+	// Constants
+	logfilename1 := "thomTide.log"
+
+	// Algorithm
+	env, err := gurobi.NewEnv(logfilename1)
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+
+	info, err := env.GetDBLParamInfo("TimeLimit")
+	if err != nil {
+		t.Errorf("There was an error getting the info of TimeLimit: %v", err)
+	}
+
+	if info.Min != 0 || info.Default != gurobi.INFINITY {
+		t.Errorf("unexpected info for TimeLimit: %+v", info)
+	}
+}
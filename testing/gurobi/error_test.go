@@ -1,6 +1,8 @@
 package gurobi_test
 
 import (
+	"errors"
+	"fmt"
 	"github.com/MatProGo-dev/Gurobi.go/gurobi"
 	"testing"
 )
@@ -49,3 +51,56 @@ func TestError_Error2(t *testing.T) {
 	}
 
 }
+
+/*
+TestError_Is1
+Description:
+
+	Verifies that errors.Is matches an Error against the sentinel with the same code,
+	even when the Error has been wrapped, and not against other sentinels.
+*/
+func TestError_Is1(t *testing.T) {
+	// Constants
+	err1 := gurobi.Error{
+		ErrorCode: gurobi.ERROR_NO_LICENSE,
+		Message:   "No Gurobi license found",
+	}
+	wrapped := fmt.Errorf("there was an issue creating the environment: %w", err1)
+
+	// Test
+	if !errors.Is(err1, gurobi.ErrNoLicense) {
+		t.Errorf("expected %v to match ErrNoLicense", err1)
+	}
+
+	if !errors.Is(wrapped, gurobi.ErrNoLicense) {
+		t.Errorf("expected the wrapped error to match ErrNoLicense")
+	}
+
+	if errors.Is(wrapped, gurobi.ErrInvalidArgument) {
+		t.Errorf("did not expect the wrapped error to match ErrInvalidArgument")
+	}
+
+	var gurobiErr gurobi.Error
+	if !errors.As(wrapped, &gurobiErr) || gurobiErr.Message != err1.Message {
+		t.Errorf("expected errors.As to recover the original Error; received %v", gurobiErr)
+	}
+}
+
+/*
+TestError_Is2
+Description:
+
+	Verifies that the error returned by the C api for an unknown attribute matches ErrUnknownAttribute.
+*/
+func TestError_Is2(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "testerror-is2")
+
+	// Algorithm
+	_, err := model.GetIntAttr("NotAGurobiAttribute")
+
+	// Test
+	if !errors.Is(err, gurobi.ErrUnknownAttribute) {
+		t.Errorf("expected ErrUnknownAttribute; received %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package gurobi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+feasrelax_test.go
+Description:
+	Tests the feasibility relaxation functions of the gurobi package.
+*/
+
+/*
+TestFeasRelax_FeasRelax1
+Description:
+
+	Verifies that FeasRelax() returns an error when the model is not initialized.
+*/
+func TestFeasRelax_FeasRelax1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	_, err := model0.FeasRelax(gurobi.FEASRELAX_LINEAR, true, nil, nil, nil, nil, nil)
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestFeasRelax_FeasRelax2
+Description:
+
+	Verifies that FeasRelax() returns an error when the number of constraints
+	and penalties do not match.
+*/
+func TestFeasRelax_FeasRelax2(t *testing.T) {
+	// Constants
+	model := newInfeasibleModel(t, "feasrelax-feasrelax2")
+
+	// Algorithm
+	_, err := model.FeasRelax(gurobi.FEASRELAX_LINEAR, true, nil, nil, nil, model.Constraints[:2], []float64{1.0})
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != (gurobi.MismatchedLengthError{
+			Length1: 2,
+			Length2: 1,
+			Name1:   "constrs",
+			Name2:   "rhsPen",
+		}).Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestFeasRelax_FeasRelax3
+Description:
+
+	Relaxes the conflicting constraints x >= 2 and x <= 1 of an infeasible model with
+	equal penalties. The minimal total violation is 1, and after optimizing the relaxed
+	model the violations of the two constraints should add up to 1.
+*/
+func TestFeasRelax_FeasRelax3(t *testing.T) {
+	// Constants
+	model := newInfeasibleModel(t, "feasrelax-feasrelax3")
+	numVars := len(model.Variables)
+	xLow, xHigh := model.Constraints[0], model.Constraints[1]
+
+	// Algorithm
+	feasObj, err := model.FeasRelax(
+		gurobi.FEASRELAX_LINEAR, true,
+		nil, nil, nil,
+		[]*gurobi.Constr{xLow, xHigh}, []float64{1.0, 1.0},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error relaxing the model: %v", err)
+	}
+
+	// Test
+	if math.Abs(feasObj-1.0) > 1e-6 {
+		t.Errorf("expected relaxation objective %v; received %v", 1.0, feasObj)
+	}
+
+	if len(model.Variables) <= numVars {
+		t.Errorf("expected handles for the artificial variables; model still has %v variables", len(model.Variables))
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	violations, err := model.FeasRelaxViolations()
+	if err != nil {
+		t.Fatalf("unexpected error reading the violations: %v", err)
+	}
+
+	total := violations.Constrs[xLow] + violations.Constrs[xHigh]
+	if math.Abs(total-1.0) > 1e-6 {
+		t.Errorf("expected the violations of x_low and x_high to add up to %v; received %v", 1.0, violations.Constrs)
+	}
+
+	if len(violations.LowerBounds) != 0 || len(violations.UpperBounds) != 0 {
+		t.Errorf("expected no bound violations; received %v and %v", violations.LowerBounds, violations.UpperBounds)
+	}
+}
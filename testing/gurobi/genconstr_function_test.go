@@ -0,0 +1,240 @@
+package gurobi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+genconstr_function_test.go
+Description:
+	Tests the function constraints (PWL, poly, exp, log, pow, ...) of the gurobi package.
+*/
+
+/*
+TestFuncConstrOptions_String1
+Description:
+
+	Verifies that the default options are written in the format that the C api expects.
+*/
+func TestFuncConstrOptions_String1(t *testing.T) {
+	// Constants
+	opts := gurobi.DefaultFuncConstrOptions()
+
+	// Test
+	expected := "FuncPieces=0 FuncPieceLength=0.01 FuncPieceError=0.001 FuncPieceRatio=-1"
+	if opts.String() != expected {
+		t.Errorf("expected options string %v; received %v", expected, opts.String())
+	}
+}
+
+/*
+TestFuncConstrOptions_String2
+Description:
+
+	Verifies that only the options which were set are written, so that the
+	other options keep Gurobi's defaults.
+*/
+func TestFuncConstrOptions_String2(t *testing.T) {
+	// Constants
+	opts := gurobi.FuncConstrOptions{}.WithFuncPieces(10)
+
+	// Test
+	expected := "FuncPieces=10"
+	if opts.String() != expected {
+		t.Errorf("expected options string %v; received %v", expected, opts.String())
+	}
+
+	if (gurobi.FuncConstrOptions{}).String() != "" {
+		t.Errorf("expected an empty options string; received %v", (gurobi.FuncConstrOptions{}).String())
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrPWL1
+Description:
+
+	Minimizes y = f(x) where f is the piecewise-linear function through
+	(0, 0), (1, 1) and (2, 3) with x fixed to 1.5. The optimal objective should be 2.
+*/
+func TestGenConstr_AddGenConstrPWL1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrpwl1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 1.5, 1.5, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+
+	_, err := model.AddGenConstrPWL(x, y, []float64{0, 1, 2}, []float64{0, 1, 3}, "y_pwl")
+	if err != nil {
+		t.Errorf("unexpected error adding PWL constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-2.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 2.0, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrPWL2
+Description:
+
+	Verifies that AddGenConstrPWL() returns an error when the x and y points
+	have different lengths.
+*/
+func TestGenConstr_AddGenConstrPWL2(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrpwl2")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+
+	// Test
+	_, err := model.AddGenConstrPWL(x, y, []float64{0, 1}, []float64{0}, "bad")
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != (gurobi.MismatchedLengthError{
+			Length1: 2,
+			Length2: 1,
+			Name1:   "xpts",
+			Name2:   "ypts",
+		}).Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrExp1
+Description:
+
+	Minimizes y = exp(x) with x fixed to 1 using a fine approximation.
+	The optimal objective should be close to e.
+*/
+func TestGenConstr_AddGenConstrExp1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrexp1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 1.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+
+	opts := gurobi.FuncConstrOptions{}.WithFuncPieces(-1).WithFuncPieceError(1e-4)
+
+	gc, err := model.AddGenConstrExp(x, y, "y_exp", &opts)
+	if err != nil {
+		t.Errorf("unexpected error adding exp constraint: %v", err)
+	}
+
+	gcType, err := gc.GenConstrType()
+	if err != nil {
+		t.Errorf("unexpected error retrieving GenConstrType: %v", err)
+	}
+
+	if gcType != gurobi.GENCONSTR_EXP {
+		t.Errorf("expected GenConstrType %v; received %v", gurobi.GENCONSTR_EXP, gcType)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-math.E) > 1e-3 {
+		t.Errorf("expected objective %v; received %v", math.E, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrLog1
+Description:
+
+	Minimizes y = ln(x) with x fixed to e (default approximation options).
+	The optimal objective should be close to 1.
+*/
+func TestGenConstr_AddGenConstrLog1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrlog1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, math.E, math.E, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+
+	if _, err := model.AddGenConstrLog(x, y, "y_log", nil); err != nil {
+		t.Errorf("unexpected error adding log constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-1.0) > 1e-2 {
+		t.Errorf("expected objective %v; received %v", 1.0, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrPow1
+Description:
+
+	Minimizes y = x^3 with x fixed to 2.
+	The optimal objective should be close to 8.
+*/
+func TestGenConstr_AddGenConstrPow1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrpow1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 2.0, 2.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+
+	if _, err := model.AddGenConstrPow(x, y, 3.0, "y_pow", nil); err != nil {
+		t.Errorf("unexpected error adding pow constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-8.0) > 1e-2 {
+		t.Errorf("expected objective %v; received %v", 8.0, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrPoly1
+Description:
+
+	Minimizes y = x^2 - 2x + 3 with x fixed to 3.
+	The optimal objective should be close to 6.
+*/
+func TestGenConstr_AddGenConstrPoly1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrpoly1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 3.0, 3.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+
+	if _, err := model.AddGenConstrPoly(x, y, []float64{1, -2, 3}, "y_poly", nil); err != nil {
+		t.Errorf("unexpected error adding poly constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-6.0) > 1e-2 {
+		t.Errorf("expected objective %v; received %v", 6.0, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrSin1
+Description:
+
+	Minimizes y = sin(x) with x fixed to pi / 2.
+	The optimal objective should be close to 1.
+*/
+func TestGenConstr_AddGenConstrSin1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrsin1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, math.Pi/2, math.Pi/2, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+
+	if _, err := model.AddGenConstrSin(x, y, "y_sin", nil); err != nil {
+		t.Errorf("unexpected error adding sin constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-1.0) > 1e-2 {
+		t.Errorf("expected objective %v; received %v", 1.0, objVal)
+	}
+}
@@ -0,0 +1,290 @@
+package gurobi_test
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+genconstr_test.go
+Description:
+	Tests the general constraint functions of the gurobi package.
+*/
+
+/*
+newTestModel
+Description:
+
+	Creates an environment and an empty model for a test with the given name.
+	The log file is removed and the env and model are freed when the test ends.
+*/
+func newTestModel(t *testing.T, name string) *gurobi.Model {
+	env, err := gurobi.NewEnv(name + ".log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env: %v", err)
+	}
+
+	model, err := gurobi.NewModel(name, env)
+	if err != nil {
+		env.Free()
+		t.Fatalf("There was an issue creating the new model: %v", err)
+	}
+
+	t.Cleanup(func() {
+		model.Free()
+		env.Free()
+		os.Remove(name + ".log")
+	})
+
+	return model
+}
+
+/*
+optimizeAndGetObjective
+Description:
+
+	Optimizes the model and returns its objective value, failing the test on errors.
+*/
+func optimizeAndGetObjective(t *testing.T, model *gurobi.Model) float64 {
+	if err := model.Optimize(); err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	objVal, err := model.GetDoubleAttr(gurobi.DBL_ATTR_OBJVAL)
+	if err != nil {
+		t.Fatalf("unexpected error retrieving ObjVal: %v", err)
+	}
+
+	return objVal
+}
+
+/*
+TestGenConstr_AddGenConstrMax1
+Description:
+
+	Verifies that AddGenConstrMax() returns an error when the model is not initialized.
+*/
+func TestGenConstr_AddGenConstrMax1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	_, err := model0.AddGenConstrMax(&gurobi.Var{}, []*gurobi.Var{}, 0.0, "max0")
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrMax2
+Description:
+
+	Minimizes z = max(x, y, 1) with x fixed to 2 and y fixed to 0.5.
+	The optimal objective should be 2.
+*/
+func TestGenConstr_AddGenConstrMax2(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrmax2")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 2.0, 2.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.5, 0.5, "y", []*gurobi.Constr{}, []float64{})
+	z, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "z", []*gurobi.Constr{}, []float64{})
+
+	gc, err := model.AddGenConstrMax(z, []*gurobi.Var{x, y}, 1.0, "z_max")
+	if err != nil {
+		t.Errorf("unexpected error adding max constraint: %v", err)
+	}
+
+	// Check attributes
+	gcType, err := gc.GenConstrType()
+	if err != nil {
+		t.Errorf("unexpected error retrieving GenConstrType: %v", err)
+	}
+
+	if gcType != gurobi.GENCONSTR_MAX {
+		t.Errorf("expected GenConstrType %v; received %v", gurobi.GENCONSTR_MAX, gcType)
+	}
+
+	gcName, err := gc.GenConstrName()
+	if err != nil {
+		t.Errorf("unexpected error retrieving GenConstrName: %v", err)
+	}
+
+	if gcName != "z_max" {
+		t.Errorf("expected GenConstrName %v; received %v", "z_max", gcName)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-2.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 2.0, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrMin1
+Description:
+
+	Maximizes z = min(x, y, 1) with x fixed to 2 and y fixed to 0.5.
+	The optimal objective should be 0.5.
+*/
+func TestGenConstr_AddGenConstrMin1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrmin1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 2.0, 2.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.5, 0.5, "y", []*gurobi.Constr{}, []float64{})
+	z, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "z", []*gurobi.Constr{}, []float64{})
+	if err := model.SetIntAttr("ModelSense", gurobi.MAXIMIZE); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	if _, err := model.AddGenConstrMin(z, []*gurobi.Var{x, y}, 1.0, "z_min"); err != nil {
+		t.Errorf("unexpected error adding min constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-0.5) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 0.5, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrAbs1
+Description:
+
+	Minimizes z = |x| with x fixed to -3.
+	The optimal objective should be 3.
+*/
+func TestGenConstr_AddGenConstrAbs1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrabs1")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 0.0, -3.0, -3.0, "x", []*gurobi.Constr{}, []float64{})
+	z, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, "z", []*gurobi.Constr{}, []float64{})
+
+	if _, err := model.AddGenConstrAbs(z, x, "z_abs"); err != nil {
+		t.Errorf("unexpected error adding abs constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-3.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 3.0, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrAnd1
+Description:
+
+	Maximizes r = a AND b with a fixed to 1 and b fixed to 0.
+	The optimal objective should be 0.
+*/
+func TestGenConstr_AddGenConstrAnd1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrand1")
+
+	a, _ := model.AddVar(gurobi.BINARY, 0.0, 1.0, 1.0, "a", []*gurobi.Constr{}, []float64{})
+	b, _ := model.AddVar(gurobi.BINARY, 0.0, 0.0, 0.0, "b", []*gurobi.Constr{}, []float64{})
+	r, _ := model.AddVar(gurobi.BINARY, 1.0, 0.0, 1.0, "r", []*gurobi.Constr{}, []float64{})
+	if err := model.SetIntAttr("ModelSense", gurobi.MAXIMIZE); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	if _, err := model.AddGenConstrAnd(r, []*gurobi.Var{a, b}, "r_and"); err != nil {
+		t.Errorf("unexpected error adding and constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 0.0, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrOr1
+Description:
+
+	Minimizes r = a OR b with a fixed to 1 and b fixed to 0.
+	The optimal objective should be 1.
+*/
+func TestGenConstr_AddGenConstrOr1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstror1")
+
+	a, _ := model.AddVar(gurobi.BINARY, 0.0, 1.0, 1.0, "a", []*gurobi.Constr{}, []float64{})
+	b, _ := model.AddVar(gurobi.BINARY, 0.0, 0.0, 0.0, "b", []*gurobi.Constr{}, []float64{})
+	r, _ := model.AddVar(gurobi.BINARY, 1.0, 0.0, 1.0, "r", []*gurobi.Constr{}, []float64{})
+
+	if _, err := model.AddGenConstrOr(r, []*gurobi.Var{a, b}, "r_or"); err != nil {
+		t.Errorf("unexpected error adding or constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-1.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 1.0, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrIndicator1
+Description:
+
+	Maximizes 10 * b - x subject to (b == 1) => (x >= 5) with 0 <= x <= 10.
+	Choosing b = 1 forces x = 5, so the optimal objective should be 5.
+*/
+func TestGenConstr_AddGenConstrIndicator1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrindicator1")
+
+	b, _ := model.AddVar(gurobi.BINARY, 10.0, 0.0, 1.0, "b", []*gurobi.Constr{}, []float64{})
+	x, _ := model.AddVar(gurobi.CONTINUOUS, -1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err := model.SetIntAttr("ModelSense", gurobi.MAXIMIZE); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	_, err := model.AddGenConstrIndicator(b, true, []*gurobi.Var{x}, []float64{1.0}, gurobi.SenseGreaterThan, 5.0, "b_implies_x")
+	if err != nil {
+		t.Errorf("unexpected error adding indicator constraint: %v", err)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-5.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 5.0, objVal)
+	}
+}
+
+/*
+TestGenConstr_AddGenConstrIndicator2
+Description:
+
+	Verifies that AddGenConstrIndicator() returns an error when the number of
+	variables and coefficients do not match.
+*/
+func TestGenConstr_AddGenConstrIndicator2(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "genconstr-addgenconstrindicator2")
+
+	b, _ := model.AddVar(gurobi.BINARY, 0.0, 0.0, 1.0, "b", []*gurobi.Constr{}, []float64{})
+
+	// Algorithm
+	_, err := model.AddGenConstrIndicator(b, true, []*gurobi.Var{}, []float64{1.0}, gurobi.SenseGreaterThan, 5.0, "bad")
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != (gurobi.MismatchedLengthError{
+			Length1: 0,
+			Length2: 1,
+			Name1:   "vars",
+			Name2:   "vals",
+		}).Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
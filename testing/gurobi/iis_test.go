@@ -0,0 +1,200 @@
+package gurobi_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+iis_test.go
+Description:
+	Tests the IIS functions of the gurobi package.
+*/
+
+/*
+newInfeasibleModel
+Description:
+
+	Creates the infeasible model
+		x_low:  x >= 2
+		x_high: x <= 1
+		y_cap:  x + y <= 10
+	with 0 <= x, y <= 5. Only x_low and x_high belong to the IIS.
+*/
+func newInfeasibleModel(t *testing.T, name string) *gurobi.Model {
+	model := newTestModel(t, name)
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 5.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 5.0, "y", []*gurobi.Constr{}, []float64{})
+
+	model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseGreaterThan, 2.0, "x_low")
+	model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 1.0, "x_high")
+	model.AddConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, gurobi.SenseLessThan, 10.0, "y_cap")
+
+	return model
+}
+
+/*
+TestIIS_ComputeIIS1
+Description:
+
+	Verifies that ComputeIIS() returns an error when the model is not initialized.
+*/
+func TestIIS_ComputeIIS1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	_, err := model0.ComputeIIS()
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestIIS_ComputeIIS2
+Description:
+
+	Computes the IIS of an infeasible model and verifies that it contains exactly
+	the two conflicting constraints, with their names resolved.
+*/
+func TestIIS_ComputeIIS2(t *testing.T) {
+	// Constants
+	model := newInfeasibleModel(t, "iis-computeiis2")
+
+	if err := model.Optimize(); err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	status, err := model.Status()
+	if err != nil {
+		t.Fatalf("unexpected error retrieving the status: %v", err)
+	}
+	if status != gurobi.INFEASIBLE {
+		t.Fatalf("expected status %v; received %v", gurobi.Status(gurobi.INFEASIBLE), status)
+	}
+
+	// Algorithm
+	report, err := model.ComputeIIS()
+	if err != nil {
+		t.Fatalf("unexpected error computing the IIS: %v", err)
+	}
+
+	// Test
+	if !report.Minimal {
+		t.Errorf("expected a minimal IIS")
+	}
+
+	if len(report.Constrs) != 2 {
+		t.Fatalf("expected 2 constraints in the IIS; received %v", report.Constrs)
+	}
+
+	expected := []gurobi.IISMember{{Index: 0, Name: "x_low"}, {Index: 1, Name: "x_high"}}
+	for i := range expected {
+		if report.Constrs[i] != expected[i] {
+			t.Errorf("expected IIS member %v; received %v", expected[i], report.Constrs[i])
+		}
+	}
+
+	if report.Len() != 2 {
+		t.Errorf("expected 2 members in the IIS; received %v (%v)", report.Len(), report)
+	}
+}
+
+/*
+TestIIS_ComputeIIS3
+Description:
+
+	Verifies that ComputeIIS() on a feasible model returns ErrIISNotInfeasible.
+*/
+func TestIIS_ComputeIIS3(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "iis-computeiis3")
+	model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+
+	if err := model.Optimize(); err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	// Algorithm
+	_, err := model.ComputeIIS()
+
+	// Test
+	if !errors.Is(err, gurobi.ErrIISNotInfeasible) {
+		t.Errorf("expected ErrIISNotInfeasible; received %v", err)
+	}
+}
+
+/*
+TestIIS_String1
+Description:
+
+	Verifies the summary written by IISReport.String().
+*/
+func TestIIS_String1(t *testing.T) {
+	// Constants
+	report := gurobi.IISReport{
+		Minimal:     true,
+		Constrs:     []gurobi.IISMember{{Index: 3, Name: "demand"}},
+		UpperBounds: []gurobi.IISMember{{Index: 0, Name: "x"}},
+	}
+
+	// Test
+	expected := "IIS with 2 members:\n" +
+		"  constraint demand (index 3)\n" +
+		"  upper bound of x (index 0)\n"
+	if report.String() != expected {
+		t.Errorf("expected %q; received %q", expected, report.String())
+	}
+}
+
+/*
+TestIIS_WriteIIS1
+Description:
+
+	Verifies that WriteIIS() refuses file names without the .ilp extension.
+*/
+func TestIIS_WriteIIS1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "iis-writeiis1")
+
+	// Algorithm
+	err := model.WriteIIS("iis-writeiis1.lp")
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	}
+}
+
+/*
+TestIIS_WriteIIS2
+Description:
+
+	Writes the IIS of an infeasible model to an .ilp file.
+*/
+func TestIIS_WriteIIS2(t *testing.T) {
+	// Constants
+	model := newInfeasibleModel(t, "iis-writeiis2")
+	filename := "iis-writeiis2.ilp"
+
+	if err := model.Optimize(); err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	// Algorithm
+	if err := model.WriteIIS(filename); err != nil {
+		t.Fatalf("unexpected error writing the IIS: %v", err)
+	}
+	defer os.Remove(filename)
+
+	// Test
+	if _, err := os.Stat(filename); err != nil {
+		t.Errorf("expected %v to exist: %v", filename, err)
+	}
+}
@@ -40,7 +40,7 @@ Description:
 func TestModel_Check1(t *testing.T) {
 	// Constants
 	model0 := gurobi.Model{
-		Variables: []gurobi.Var{},
+		Variables: []*gurobi.Var{},
 	}
 
 	// Tests
@@ -992,3 +992,592 @@ func TestModel_AddConstrs6(t *testing.T) {
 	}
 
 }
+
+/*
+TestModel_AddRangeConstr1
+Description:
+
+	Minimizes x subject to 2 <= x + y <= 5 with y fixed to 0.
+	The optimal objective should be 2 and the range variable created
+	by Gurobi should be tracked in model.Variables.
+*/
+func TestModel_AddRangeConstr1(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-addrangeconstr1")
+
+	x, _ := model0.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 0.0, "y", []*gurobi.Constr{}, []float64{})
+
+	// Test
+	_, err := model0.AddRangeConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, 2.0, 5.0, "range0")
+	if err != nil {
+		t.Errorf("unexpected error adding range constraint: %v", err)
+	}
+
+	numVars, err := model0.GetIntAttr("NumVars")
+	if err != nil {
+		t.Errorf("unexpected error retrieving NumVars: %v", err)
+	}
+
+	if int(numVars) != len(model0.Variables) {
+		t.Errorf("NumVars (%v) does not match len(model.Variables) (%v)", numVars, len(model0.Variables))
+	}
+
+	if objVal := optimizeAndGetObjective(t, model0); objVal != 2.0 {
+		t.Errorf("expected objective %v; received %v", 2.0, objVal)
+	}
+}
+
+/*
+TestModel_AddRangeConstr2
+Description:
+
+	Verifies that AddRangeConstr() returns an error when the number of
+	variables and coefficients do not match.
+*/
+func TestModel_AddRangeConstr2(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-addrangeconstr2")
+
+	// Test
+	_, err := model0.AddRangeConstr([]*gurobi.Var{}, []float64{1.0}, 2.0, 5.0, "range0")
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != (gurobi.MismatchedLengthError{
+			Length1: 0,
+			Length2: 1,
+			Name1:   "vars",
+			Name2:   "vals",
+		}).Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestModel_ChgCoeffs1
+Description:
+
+	Maximizes x subject to x <= 4, then changes the constraint to 2x <= 4
+	and reoptimizes. The objective should go from 4 to 2 and GetCoeff() should
+	report the new coefficient.
+*/
+func TestModel_ChgCoeffs1(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-chgcoeffs1")
+
+	x, _ := model0.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	if err := model0.SetIntAttr("ModelSense", gurobi.MAXIMIZE); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	c0, err := model0.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 4.0, "c0")
+	if err != nil {
+		t.Errorf("unexpected error adding constraint: %v", err)
+	}
+
+	if objVal := optimizeAndGetObjective(t, model0); objVal != 4.0 {
+		t.Errorf("expected initial objective %v; received %v", 4.0, objVal)
+	}
+
+	// Change coefficient
+	if err := model0.ChgCoeffs([]*gurobi.Constr{c0}, []*gurobi.Var{x}, []float64{2.0}); err != nil {
+		t.Errorf("unexpected error changing coefficients: %v", err)
+	}
+
+	coeff, err := model0.GetCoeff(c0, x)
+	if err != nil {
+		t.Errorf("unexpected error retrieving coefficient: %v", err)
+	}
+
+	if coeff != 2.0 {
+		t.Errorf("expected coefficient %v; received %v", 2.0, coeff)
+	}
+
+	if objVal := optimizeAndGetObjective(t, model0); objVal != 2.0 {
+		t.Errorf("expected new objective %v; received %v", 2.0, objVal)
+	}
+}
+
+/*
+TestModel_ChgCoeffs2
+Description:
+
+	Verifies that ChgCoeffs() returns an error when the number of
+	constraints and variables do not match.
+*/
+func TestModel_ChgCoeffs2(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-chgcoeffs2")
+
+	x, _ := model0.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+
+	// Test
+	err := model0.ChgCoeffs([]*gurobi.Constr{}, []*gurobi.Var{x}, []float64{2.0})
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != (gurobi.MismatchedLengthError{
+			Length1: 0,
+			Length2: 1,
+			Name1:   "constrs",
+			Name2:   "vars",
+		}).Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestModel_GetRow1
+Description:
+
+	Verifies that GetRow() returns the variables and coefficients
+	of a constraint 3x + 5z <= 1 (y does not appear).
+*/
+func TestModel_GetRow1(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-getrow1")
+
+	x, _ := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	_, _ = model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	z, _ := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "z", []*gurobi.Constr{}, []float64{})
+
+	c0, err := model0.AddConstr([]*gurobi.Var{x, z}, []float64{3.0, 5.0}, gurobi.SenseLessThan, 1.0, "c0")
+	if err != nil {
+		t.Errorf("unexpected error adding constraint: %v", err)
+	}
+
+	// Test
+	vars, vals, err := model0.GetRow(c0)
+	if err != nil {
+		t.Errorf("unexpected error retrieving row: %v", err)
+	}
+
+	if len(vars) != 2 || len(vals) != 2 {
+		t.Fatalf("expected 2 nonzeros in the row; received %v and %v", len(vars), len(vals))
+	}
+
+	if vars[0].Index != x.Index || vals[0] != 3.0 {
+		t.Errorf("expected first entry (%v, %v); received (%v, %v)", x.Index, 3.0, vars[0].Index, vals[0])
+	}
+
+	if vars[1].Index != z.Index || vals[1] != 5.0 {
+		t.Errorf("expected second entry (%v, %v); received (%v, %v)", z.Index, 5.0, vars[1].Index, vals[1])
+	}
+}
+
+/*
+TestModel_DelVars1
+Description:
+
+	Adds x, y and z, deletes y and verifies that the handles of x and z
+	still refer to the right variables while y's handle reports deletion.
+*/
+func TestModel_DelVars1(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-delvars1")
+
+	x, _ := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	z, _ := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "z", []*gurobi.Constr{}, []float64{})
+
+	// Test
+	if err := model0.DelVars([]*gurobi.Var{y}); err != nil {
+		t.Errorf("unexpected error deleting y: %v", err)
+	}
+
+	if y.Index != -1 {
+		t.Errorf("expected deleted variable to have index -1; received %v", y.Index)
+	}
+
+	numVars, err := model0.GetIntAttr("NumVars")
+	if err != nil {
+		t.Errorf("unexpected error retrieving NumVars: %v", err)
+	}
+
+	if numVars != 2 || len(model0.Variables) != 2 {
+		t.Errorf("expected 2 variables; received %v (%v in model.Variables)", numVars, len(model0.Variables))
+	}
+
+	for _, pair := range []struct {
+		v    *gurobi.Var
+		name string
+	}{{x, "x"}, {z, "z"}} {
+		name, err := pair.v.GetString("VarName")
+		if err != nil {
+			t.Errorf("unexpected error retrieving the name of %v: %v", pair.name, err)
+		}
+		if name != pair.name {
+			t.Errorf("expected handle to refer to %v; it refers to %v", pair.name, name)
+		}
+	}
+}
+
+/*
+TestModel_DelVars2
+Description:
+
+	Verifies that DelVars() returns an error when given a variable which
+	was already deleted.
+*/
+func TestModel_DelVars2(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-delvars2")
+
+	x, _ := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+
+	if err := model0.DelVars([]*gurobi.Var{x}); err != nil {
+		t.Errorf("unexpected error deleting x: %v", err)
+	}
+
+	// Test
+	if err := model0.DelVars([]*gurobi.Var{x}); err == nil {
+		t.Errorf("expected an error when deleting x twice, but received none!")
+	}
+}
+
+/*
+TestModel_DelVars3
+Description:
+
+	Deletes variables in two separate calls and then adds another variable while holding
+	on to the original handles. Every surviving handle must keep referring to its variable
+	and the deleted handles must be rejected.
+*/
+func TestModel_DelVars3(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-delvars3")
+
+	names := []string{"a", "b", "c", "d", "e"}
+	vars := make([]*gurobi.Var, len(names))
+	for i, name := range names {
+		vars[i], _ = model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, name, []*gurobi.Constr{}, []float64{})
+	}
+
+	// Algorithm
+	if err := model0.DelVars([]*gurobi.Var{vars[1]}); err != nil {
+		t.Errorf("unexpected error deleting b: %v", err)
+	}
+	if err := model0.DelVars([]*gurobi.Var{vars[3]}); err != nil {
+		t.Errorf("unexpected error deleting d: %v", err)
+	}
+	if _, err := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "f", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("unexpected error adding f: %v", err)
+	}
+
+	// Test
+	for _, i := range []int{0, 2, 4} {
+		name, err := vars[i].GetString("VarName")
+		if err != nil {
+			t.Errorf("unexpected error retrieving the name of %v: %v", names[i], err)
+		}
+		if name != names[i] {
+			t.Errorf("expected handle to refer to %v; it refers to %v", names[i], name)
+		}
+	}
+
+	if err := model0.DelVars([]*gurobi.Var{vars[1]}); err == nil {
+		t.Errorf("expected an error when deleting b a second time, but received none!")
+	}
+}
+
+/*
+TestModel_DelConstrs1
+Description:
+
+	Adds three constraints, deletes the first two and verifies that the
+	remaining handle still refers to the right constraint.
+*/
+func TestModel_DelConstrs1(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-delconstrs1")
+
+	x, _ := model0.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	if err := model0.SetIntAttr("ModelSense", gurobi.MAXIMIZE); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	c0, _ := model0.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 1.0, "c0")
+	c1, _ := model0.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 2.0, "c1")
+	c2, _ := model0.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 3.0, "c2")
+
+	// Test
+	if err := model0.DelConstrs([]*gurobi.Constr{c0, c1}); err != nil {
+		t.Errorf("unexpected error deleting constraints: %v", err)
+	}
+
+	if c0.Index != -1 || c1.Index != -1 {
+		t.Errorf("expected deleted constraints to have index -1; received %v and %v", c0.Index, c1.Index)
+	}
+
+	if c2.Index != 0 || len(model0.Constraints) != 1 {
+		t.Errorf("expected c2 to be the only constraint (index 0); received index %v with %v constraints", c2.Index, len(model0.Constraints))
+	}
+
+	if objVal := optimizeAndGetObjective(t, model0); objVal != 3.0 {
+		t.Errorf("expected objective %v; received %v", 3.0, objVal)
+	}
+}
+
+/*
+TestModel_AddVar4
+Description:
+
+	Adds thousands of variables one at a time while holding on to every handle.
+	Growing model.Variables must not invalidate the handles returned earlier.
+*/
+func TestModel_AddVar4(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-addvar4")
+	numVars := 5000
+
+	// Algorithm
+	vars := make([]*gurobi.Var, numVars)
+	for i := range vars {
+		v, err := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, fmt.Sprintf("x%v", i), []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Fatalf("unexpected error adding variable %v: %v", i, err)
+		}
+		vars[i] = v
+	}
+
+	// Test
+	for i, v := range vars {
+		if v != model0.Variables[i] || v.Index != int32(i) {
+			t.Fatalf("handle %v no longer refers to model.Variables[%v] (index %v)", i, i, v.Index)
+		}
+	}
+
+	for _, i := range []int{0, numVars / 2, numVars - 1} {
+		name, err := vars[i].GetString("VarName")
+		if err != nil {
+			t.Errorf("unexpected error retrieving VarName of variable %v: %v", i, err)
+		}
+		if name != fmt.Sprintf("x%v", i) {
+			t.Errorf("expected handle %v to refer to x%v; it refers to %v", i, i, name)
+		}
+	}
+}
+
+/*
+TestModel_AddVars10
+Description:
+
+	Adds thousands of variables through many calls to AddVars() and checks that
+	the handles from every call still refer to the right variables.
+*/
+func TestModel_AddVars10(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-addvars10")
+	numCalls := 200
+	batchSize := 25
+
+	// Algorithm
+	batches := make([][]*gurobi.Var, numCalls)
+	for k := range batches {
+		vtypes := make([]int8, batchSize)
+		objs := make([]float64, batchSize)
+		lbs := make([]float64, batchSize)
+		ubs := make([]float64, batchSize)
+		names := make([]string, batchSize)
+		for j := 0; j < batchSize; j++ {
+			vtypes[j] = gurobi.CONTINUOUS
+			ubs[j] = 1.0
+			names[j] = fmt.Sprintf("x%v_%v", k, j)
+		}
+
+		vars, err := model0.AddVars(vtypes, objs, lbs, ubs, names, [][]*gurobi.Constr{}, [][]float64{})
+		if err != nil {
+			t.Fatalf("unexpected error in call %v of AddVars: %v", k, err)
+		}
+		batches[k] = vars
+	}
+
+	// Test
+	if len(model0.Variables) != numCalls*batchSize {
+		t.Errorf("expected %v variables; received %v", numCalls*batchSize, len(model0.Variables))
+	}
+
+	for k, vars := range batches {
+		for j, v := range vars {
+			if v != model0.Variables[k*batchSize+j] {
+				t.Fatalf("handle %v of call %v no longer refers to its variable", j, k)
+			}
+		}
+	}
+
+	name, err := batches[0][0].GetString("VarName")
+	if err != nil {
+		t.Errorf("unexpected error retrieving VarName: %v", err)
+	}
+	if name != "x0_0" {
+		t.Errorf("expected the first handle to refer to x0_0; it refers to %v", name)
+	}
+}
+
+/*
+TestModel_AddConstrs7
+Description:
+
+	Adds thousands of constraints through many calls to AddConstr() and AddConstrs()
+	and checks that the retained handles still refer to the right constraints.
+*/
+func TestModel_AddConstrs7(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-addconstrs7")
+	numCalls := 1000
+
+	x, err := model0.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Fatalf("unexpected error adding variable: %v", err)
+	}
+
+	// Algorithm
+	var constrs []*gurobi.Constr
+	for k := 0; k < numCalls; k++ {
+		c, err := model0.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 1.0, fmt.Sprintf("c%v", 3*k))
+		if err != nil {
+			t.Fatalf("unexpected error in call %v of AddConstr: %v", k, err)
+		}
+		constrs = append(constrs, c)
+
+		cs, err := model0.AddConstrs(
+			[][]*gurobi.Var{{x}, {x}},
+			[][]float64{{1.0}, {1.0}},
+			[]int8{gurobi.SenseLessThan, gurobi.SenseLessThan},
+			[]float64{1.0, 1.0},
+			[]string{fmt.Sprintf("c%v", 3*k+1), fmt.Sprintf("c%v", 3*k+2)},
+		)
+		if err != nil {
+			t.Fatalf("unexpected error in call %v of AddConstrs: %v", k, err)
+		}
+		constrs = append(constrs, cs...)
+	}
+
+	// Test
+	for i, c := range constrs {
+		if c != model0.Constraints[i] || c.Index != int32(i) {
+			t.Fatalf("handle %v no longer refers to model.Constraints[%v] (index %v)", i, i, c.Index)
+		}
+	}
+}
+
+/*
+TestModel_ReadModel1
+Description:
+
+	Verifies that the ReadModel() function returns an error when
+	called with an uninitialized environment.
+*/
+func TestModel_ReadModel1(t *testing.T) {
+	// Constants
+	var env0 *gurobi.Env
+
+	// Algorithm
+	_, err := gurobi.ReadModel(env0, "testmodel-readmodel1.lp")
+	if err == nil {
+		t.Errorf("expected error to be thrown, but none were detected!")
+	} else {
+		if err.Error() != env0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestModel_ReadModel2
+Description:
+
+	Writes a small LP to a file, reads it back with ReadModel() and verifies that
+	the handles of the new model refer to the variables and constraints from the file.
+*/
+func TestModel_ReadModel2(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-readmodel2")
+	filename := "testmodel-readmodel2.lp"
+
+	x, _ := model0.AddVar(gurobi.CONTINUOUS, -1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model0.AddVar(gurobi.CONTINUOUS, -2.0, 0.0, 10.0, "y", []*gurobi.Constr{}, []float64{})
+	if _, err := model0.AddConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, gurobi.SenseLessThan, 4.0, "budget"); err != nil {
+		t.Errorf("unexpected error adding constraint: %v", err)
+	}
+
+	if err := model0.Write(filename); err != nil {
+		t.Fatalf("unexpected error writing the model: %v", err)
+	}
+	defer os.Remove(filename)
+
+	// Algorithm
+	model1, err := gurobi.ReadModel(&model0.Env, filename)
+	if err != nil {
+		t.Fatalf("unexpected error reading the model: %v", err)
+	}
+	defer model1.Free()
+
+	// Test
+	if len(model1.Variables) != 2 || len(model1.Constraints) != 1 {
+		t.Errorf(
+			"expected 2 variables and 1 constraint; received %v and %v",
+			len(model1.Variables), len(model1.Constraints),
+		)
+	}
+
+	for i, expected := range []string{"x", "y"} {
+		name, err := model1.Variables[i].GetString("VarName")
+		if err != nil {
+			t.Errorf("unexpected error retrieving VarName: %v", err)
+		}
+		if name != expected {
+			t.Errorf("expected variable %v to be %v; received %v", i, expected, name)
+		}
+	}
+
+	rowVars, rowVals, err := model1.GetRow(model1.Constraints[0])
+	if err != nil {
+		t.Errorf("unexpected error retrieving the row of the constraint: %v", err)
+	}
+	if len(rowVars) != 2 || rowVars[0] != model1.Variables[0] || rowVals[0] != 1.0 {
+		t.Errorf("unexpected row for the constraint read from the file: %v, %v", rowVars, rowVals)
+	}
+
+	if objVal := optimizeAndGetObjective(t, model1); objVal != -8.0 {
+		t.Errorf("expected objective %v; received %v", -8.0, objVal)
+	}
+}
+
+/*
+TestModel_Read1
+Description:
+
+	Reads a MIP start (.mst) file into a model and verifies that the
+	Start attribute of the variable was set from it.
+*/
+func TestModel_Read1(t *testing.T) {
+	// Constants
+	model0 := newTestModel(t, "testmodel-read1")
+	filename := "testmodel-read1.mst"
+
+	x, _ := model0.AddVar(gurobi.INTEGER, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+
+	if err := os.WriteFile(filename, []byte("# MIP start\nx 3\n"), 0644); err != nil {
+		t.Fatalf("unexpected error writing the MIP start file: %v", err)
+	}
+	defer os.Remove(filename)
+
+	// Algorithm
+	if err := model0.Read(filename); err != nil {
+		t.Errorf("unexpected error reading the MIP start: %v", err)
+	}
+
+	// Test
+	start, err := x.GetDouble("Start")
+	if err != nil {
+		t.Errorf("unexpected error retrieving Start: %v", err)
+	}
+
+	if start != 3.0 {
+		t.Errorf("expected Start to be %v; received %v", 3.0, start)
+	}
+}
@@ -0,0 +1,185 @@
+package gurobi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+optimize_async_test.go
+Description:
+	Tests the asynchronous optimization functions of the gurobi package.
+*/
+
+/*
+TestModel_OptimizeContext1
+Description:
+
+	Verifies that OptimizeContext() returns an error when the model is not initialized.
+*/
+func TestModel_OptimizeContext1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	err := model0.OptimizeContext(context.Background())
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestModel_OptimizeContext2
+Description:
+
+	Solves min x + y s.t. x + y >= 1 with a context that is never cancelled.
+	The optimal objective should be 1, just as with Optimize().
+*/
+func TestModel_OptimizeContext2(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "model-optimizecontext2")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+	model.AddConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, gurobi.SenseGreaterThan, 1.0, "c")
+
+	// Algorithm
+	if err := model.OptimizeContext(context.Background()); err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	// Test
+	status, err := model.Status()
+	if err != nil {
+		t.Errorf("unexpected error retrieving the status: %v", err)
+	}
+	if status != gurobi.OPTIMAL {
+		t.Errorf("expected status %v; received %v", gurobi.Status(gurobi.OPTIMAL), status)
+	}
+
+	objVal, err := model.GetDoubleAttr(gurobi.DBL_ATTR_OBJVAL)
+	if err != nil {
+		t.Errorf("unexpected error retrieving ObjVal: %v", err)
+	}
+	if math.Abs(objVal-1.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 1.0, objVal)
+	}
+}
+
+/*
+TestModel_OptimizeContext3
+Description:
+
+	Verifies that OptimizeContext() does not start optimizing when the context
+	is already cancelled.
+*/
+func TestModel_OptimizeContext3(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "model-optimizecontext3")
+	model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Algorithm
+	err := model.OptimizeContext(ctx)
+
+	// Test
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled; received %v", err)
+	}
+
+	status, err := model.Status()
+	if err != nil {
+		t.Errorf("unexpected error retrieving the status: %v", err)
+	}
+	if status != gurobi.LOADED {
+		t.Errorf("expected status %v; received %v", gurobi.Status(gurobi.LOADED), status)
+	}
+}
+
+/*
+TestModel_OptimizeContext4
+Description:
+
+	Builds a market split instance (which is very hard for branch and bound) and
+	optimizes it with a 200ms deadline. OptimizeContext() should return shortly after
+	the deadline with context.DeadlineExceeded and the model should be INTERRUPTED,
+	unless Gurobi finished first, in which case it returns nil.
+*/
+func TestModel_OptimizeContext4(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "model-optimizecontext4")
+	numRows, numCols := 6, 60
+	rng := rand.New(rand.NewSource(7))
+
+	vars := make([]*gurobi.Var, numCols)
+	for j := range vars {
+		vars[j], _ = model.AddVar(gurobi.BINARY, 0.0, 0.0, 1.0, fmt.Sprintf("x%v", j), []*gurobi.Constr{}, []float64{})
+	}
+
+	for i := 0; i < numRows; i++ {
+		coeffs := make([]float64, numCols)
+		sum := 0.0
+		for j := range coeffs {
+			coeffs[j] = float64(rng.Intn(100))
+			sum += coeffs[j]
+		}
+		splus, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, fmt.Sprintf("splus%v", i), []*gurobi.Constr{}, []float64{})
+		sminus, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, fmt.Sprintf("sminus%v", i), []*gurobi.Constr{}, []float64{})
+
+		rowVars := append(append([]*gurobi.Var{}, vars...), splus, sminus)
+		rowCoeffs := append(coeffs, 1.0, -1.0)
+		if _, err := model.AddConstr(rowVars, rowCoeffs, gurobi.SenseEqual, math.Floor(sum/2), fmt.Sprintf("row%v", i)); err != nil {
+			t.Fatalf("unexpected error adding row %v: %v", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	// Algorithm
+	start := time.Now()
+	err := model.OptimizeContext(ctx)
+	elapsed := time.Since(start)
+
+	// Test
+	if elapsed > 5*time.Second {
+		t.Errorf("expected OptimizeContext to return shortly after the deadline; took %v", elapsed)
+	}
+
+	if err == nil {
+		// Gurobi finished before the termination took effect, so the solve was not interrupted.
+		status, err := model.Status()
+		if err != nil {
+			t.Errorf("unexpected error retrieving the status: %v", err)
+		}
+		if status == gurobi.INTERRUPTED {
+			t.Errorf("expected no error only for a solve which was not interrupted")
+		}
+		return
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded; received %v", err)
+	}
+
+	status, err := model.Status()
+	if err != nil {
+		t.Errorf("unexpected error retrieving the status: %v", err)
+	}
+	if status != gurobi.INTERRUPTED {
+		t.Errorf("expected status %v; received %v", gurobi.Status(gurobi.INTERRUPTED), status)
+	}
+}
@@ -0,0 +1,156 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+param_test.go
+Description:
+	Tests the parameter functions of the gurobi package.
+*/
+
+/*
+TestModelParams_SetDouble1
+Description:
+
+	Verifies that ModelParams.SetDouble() returns an error when the model is not initialized.
+*/
+func TestModelParams_SetDouble1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	err := model0.Params().SetDouble("TimeLimit", 60)
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestModelParams_SetDouble2
+Description:
+
+	Sets the TimeLimit of a model and verifies that the model's environment changes
+	while the environment that the model was created from keeps its value.
+*/
+func TestModelParams_SetDouble2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("testmodelparams-setdouble2.log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+
+	model, err := gurobi.NewModel("testmodelparams-setdouble2", env)
+	if err != nil {
+		t.Fatalf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	// Algorithm
+	if err := model.Params().SetDouble("TimeLimit", 60); err != nil {
+		t.Errorf("unexpected error setting TimeLimit: %v", err)
+	}
+
+	// Test
+	modelLimit, err := model.Params().GetDouble("TimeLimit")
+	if err != nil {
+		t.Errorf("unexpected error getting TimeLimit of the model: %v", err)
+	}
+
+	if modelLimit != 60 {
+		t.Errorf("expected the model's TimeLimit to be %v; received %v", 60, modelLimit)
+	}
+
+	envLimit, err := env.GetDBLParam("TimeLimit")
+	if err != nil {
+		t.Errorf("unexpected error getting TimeLimit of the env: %v", err)
+	}
+
+	if envLimit != gurobi.INFINITY {
+		t.Errorf("expected the original env to keep its TimeLimit; received %v", envLimit)
+	}
+}
+
+/*
+TestModelParams_SetDouble3
+Description:
+
+	Sets a TimeLimit of 0 on the model and verifies that the optimization
+	stops because of it.
+*/
+func TestModelParams_SetDouble3(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "testmodelparams-setdouble3")
+
+	x, _ := model.AddVar(gurobi.INTEGER, -1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.INTEGER, -1.0, 0.0, 10.0, "y", []*gurobi.Constr{}, []float64{})
+	if _, err := model.AddConstr([]*gurobi.Var{x, y}, []float64{2.0, 3.0}, gurobi.SenseLessThan, 17.5, "c0"); err != nil {
+		t.Errorf("unexpected error adding constraint: %v", err)
+	}
+
+	// Algorithm
+	if err := model.Params().SetDouble("TimeLimit", 0); err != nil {
+		t.Errorf("unexpected error setting TimeLimit: %v", err)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("unexpected error while optimizing: %v", err)
+	}
+
+	// Test
+	status, err := model.GetIntAttr(gurobi.INT_ATTR_STATUS)
+	if err != nil {
+		t.Errorf("unexpected error retrieving the status: %v", err)
+	}
+
+	if status != gurobi.TIME_LIMIT {
+		t.Errorf("expected status %v (TIME_LIMIT); received %v", gurobi.TIME_LIMIT, status)
+	}
+}
+
+/*
+TestModelParams_Set1
+Description:
+
+	Sets integer and string parameters of a model through Set() and reads them back.
+*/
+func TestModelParams_Set1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "testmodelparams-set1")
+
+	// Algorithm
+	if err := model.Params().Set("Threads", "1"); err != nil {
+		t.Errorf("unexpected error setting Threads: %v", err)
+	}
+
+	if err := model.Params().SetString("ResultFile", ""); err != nil {
+		t.Errorf("unexpected error setting ResultFile: %v", err)
+	}
+
+	// Test
+	threads, err := model.Params().GetInt("Threads")
+	if err != nil {
+		t.Errorf("unexpected error getting Threads: %v", err)
+	}
+
+	if threads != 1 {
+		t.Errorf("expected Threads to be %v; received %v", 1, threads)
+	}
+
+	resultFile, err := model.Params().GetString("ResultFile")
+	if err != nil {
+		t.Errorf("unexpected error getting ResultFile: %v", err)
+	}
+
+	if resultFile != "" {
+		t.Errorf("expected ResultFile to be empty; received %v", resultFile)
+	}
+}
@@ -0,0 +1,184 @@
+package gurobi_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+paramset_test.go
+Description:
+	Tests the ParamSet type and the functions for reading and writing parameter files.
+*/
+
+/*
+TestParamSet_Diff1
+Description:
+
+	Verifies that Diff() keeps exactly the parameters which are missing from the base
+	set or have a different value there.
+*/
+func TestParamSet_Diff1(t *testing.T) {
+	// Constants
+	ps := gurobi.NewParamSet()
+	ps.Int["Threads"] = 2
+	ps.Int["MIPFocus"] = 0
+	ps.Double["TimeLimit"] = 60
+	ps.String["LogFile"] = "gurobi.log"
+
+	base := gurobi.NewParamSet()
+	base.Int["Threads"] = 0
+	base.Int["MIPFocus"] = 0
+	base.Double["TimeLimit"] = gurobi.INFINITY
+
+	// Algorithm
+	diff := ps.Diff(base)
+
+	// Test
+	if diff.Len() != 3 {
+		t.Errorf("expected 3 differing parameters; received %v (%+v)", diff.Len(), diff)
+	}
+
+	if diff.Int["Threads"] != 2 || diff.Double["TimeLimit"] != 60 || diff.String["LogFile"] != "gurobi.log" {
+		t.Errorf("unexpected difference: %+v", diff)
+	}
+
+	if _, ok := diff.Int["MIPFocus"]; ok {
+		t.Errorf("MIPFocus has the same value in both sets and should not be in the difference")
+	}
+}
+
+/*
+TestParamSet_JSON1
+Description:
+
+	Verifies that a ParamSet survives a round trip through JSON.
+*/
+func TestParamSet_JSON1(t *testing.T) {
+	// Constants
+	ps := gurobi.NewParamSet()
+	ps.Int["Method"] = 2
+	ps.Double["MIPGap"] = 1e-3
+	ps.String["ResultFile"] = "out.sol"
+
+	// Algorithm
+	data, err := json.Marshal(ps)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling the ParamSet: %v", err)
+	}
+
+	var decoded gurobi.ParamSet
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error unmarshalling the ParamSet: %v", err)
+	}
+
+	// Test
+	if diff := decoded.Diff(ps); diff.Len() != 0 || decoded.Len() != ps.Len() {
+		t.Errorf("expected the decoded ParamSet to equal the original; received %s", data)
+	}
+}
+
+/*
+TestEnv_WriteParams1
+Description:
+
+	Writes the changed parameters of one environment to a .prm file, reads them into another
+	and verifies that exactly those parameters differ from the defaults there.
+*/
+func TestEnv_WriteParams1(t *testing.T) {
+	// Constants
+	filename := "testenv-writeparams1.prm"
+
+	env1, err := gurobi.NewEnv("testenv-writeparams1.log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env1.Free()
+	defer os.Remove("testenv-writeparams1.log")
+
+	env2, err := gurobi.NewEnv("testenv-writeparams1.log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env2.Free()
+
+	if err := env1.SetIntParam("MIPFocus", 1); err != nil {
+		t.Errorf("unexpected error setting MIPFocus: %v", err)
+	}
+	if err := env1.SetDBLParam("MIPGap", 0.05); err != nil {
+		t.Errorf("unexpected error setting MIPGap: %v", err)
+	}
+
+	// Algorithm
+	if err := env1.WriteParams(filename); err != nil {
+		t.Fatalf("unexpected error writing the parameters: %v", err)
+	}
+	defer os.Remove(filename)
+
+	if err := env2.ReadParams(filename); err != nil {
+		t.Fatalf("unexpected error reading the parameters: %v", err)
+	}
+
+	// Test
+	changed, err := env2.NonDefaultParams()
+	if err != nil {
+		t.Fatalf("unexpected error collecting the non-default parameters: %v", err)
+	}
+
+	if changed.Int["MIPFocus"] != 1 || changed.Double["MIPGap"] != 0.05 {
+		t.Errorf("expected MIPFocus and MIPGap to be read from the file; received %+v", changed)
+	}
+
+	for name := range changed.String {
+		if name != "LogFile" {
+			t.Errorf("unexpected non-default string parameter %v", name)
+		}
+	}
+}
+
+/*
+TestParamSet_Apply1
+Description:
+
+	Captures the parameters of an environment and applies them to a model, then
+	verifies that the model uses them.
+*/
+func TestParamSet_Apply1(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "testparamset-apply1")
+
+	env, err := gurobi.NewEnv("testparamset-apply1-tuned.log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("testparamset-apply1-tuned.log")
+
+	if err := env.SetIntParam("Method", 2); err != nil {
+		t.Errorf("unexpected error setting Method: %v", err)
+	}
+
+	ps, err := env.NonDefaultParams()
+	if err != nil {
+		t.Fatalf("unexpected error capturing the parameters: %v", err)
+	}
+	delete(ps.String, "LogFile")
+
+	// Algorithm
+	if err := model.Params().Apply(ps); err != nil {
+		t.Errorf("unexpected error applying the parameters: %v", err)
+	}
+
+	// Test
+	method, err := model.Params().GetInt("Method")
+	if err != nil {
+		t.Errorf("unexpected error getting Method: %v", err)
+	}
+
+	if method != 2 {
+		t.Errorf("expected Method to be %v; received %v", 2, method)
+	}
+}
@@ -0,0 +1,227 @@
+package gurobi_test
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+qconstr_test.go
+Description:
+	Tests the quadratic constraint functions of the gurobi package.
+*/
+
+/*
+TestQConstr_AddQConstr1
+Description:
+
+	Verifies that AddQConstr() returns an error when the model is not initialized.
+*/
+func TestQConstr_AddQConstr1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	_, err := model0.AddQConstr(&gurobi.QuadExpr{}, gurobi.SenseLessThan, 1.0, "qc0")
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestQConstr_AddQConstr2
+Description:
+
+	Minimizes x + y over the unit disk (x^2 + y^2 <= 1) and checks that the
+	optimal objective is -sqrt(2) with an active (zero-slack) quadratic constraint.
+*/
+func TestQConstr_AddQConstr2(t *testing.T) {
+	// Constants
+	testName := "qconstr-addqconstr2"
+
+	env, err := gurobi.NewEnv(testName + ".log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env: %v", err)
+	}
+	defer os.Remove(testName + ".log")
+	defer env.Free()
+
+	model, err := gurobi.NewModel(testName, env)
+	if err != nil {
+		t.Fatalf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.CONTINUOUS, 1.0, -gurobi.INFINITY, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+
+	// Create disk constraint
+	expr := gurobi.QuadExpr{}
+	expr.AddQTerm(x, x, 1.0).AddQTerm(y, y, 1.0)
+	qc, err := model.AddQConstr(&expr, gurobi.SenseLessThan, 1.0, "disk")
+	if err != nil {
+		t.Errorf("unexpected error adding quadratic constraint: %v", err)
+	}
+
+	// Optimize
+	if err := model.Optimize(); err != nil {
+		t.Errorf("unexpected error while optimizing: %v", err)
+	}
+
+	objVal, err := model.GetDoubleAttr(gurobi.DBL_ATTR_OBJVAL)
+	if err != nil {
+		t.Errorf("unexpected error retrieving ObjVal: %v", err)
+	}
+
+	if math.Abs(objVal+math.Sqrt2) > 1e-5 {
+		t.Errorf("expected objective %v; received %v", -math.Sqrt2, objVal)
+	}
+
+	slack, err := qc.QCSlack()
+	if err != nil {
+		t.Errorf("unexpected error retrieving QCSlack: %v", err)
+	}
+
+	if math.Abs(slack) > 1e-5 {
+		t.Errorf("expected the disk constraint to be active; slack was %v", slack)
+	}
+
+	name, err := qc.QCName()
+	if err != nil {
+		t.Errorf("unexpected error retrieving QCName: %v", err)
+	}
+
+	if name != "disk" {
+		t.Errorf("expected name %v; received %v", "disk", name)
+	}
+}
+
+/*
+TestQConstr_DelQConstrs1
+Description:
+
+	Verifies that deleting the first of two quadratic constraints invalidates its
+	handle and renumbers the remaining one.
+*/
+func TestQConstr_DelQConstrs1(t *testing.T) {
+	// Constants
+	testName := "qconstr-delqconstrs1"
+
+	env, err := gurobi.NewEnv(testName + ".log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env: %v", err)
+	}
+	defer os.Remove(testName + ".log")
+	defer env.Free()
+
+	model, err := gurobi.NewModel(testName, env)
+	if err != nil {
+		t.Fatalf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.CONTINUOUS, 0.0, -gurobi.INFINITY, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+
+	expr := gurobi.QuadExpr{}
+	expr.AddQTerm(x, x, 1.0)
+	qc0, err := model.AddQConstr(&expr, gurobi.SenseLessThan, 1.0, "qc0")
+	if err != nil {
+		t.Errorf("unexpected error adding qc0: %v", err)
+	}
+	_, err = model.AddQConstr(&expr, gurobi.SenseLessThan, 4.0, "qc1")
+	if err != nil {
+		t.Errorf("unexpected error adding qc1: %v", err)
+	}
+
+	// Delete the first constraint
+	if err := model.DelQConstrs([]*gurobi.QConstr{qc0}); err != nil {
+		t.Errorf("unexpected error deleting qc0: %v", err)
+	}
+
+	if qc0.Index != -1 {
+		t.Errorf("expected the deleted constraint to have index -1; received %v", qc0.Index)
+	}
+
+	numQConstrs, err := model.GetIntAttr("NumQConstrs")
+	if err != nil {
+		t.Errorf("unexpected error retrieving NumQConstrs: %v", err)
+	}
+
+	if numQConstrs != 1 || len(model.QConstraints) != 1 {
+		t.Errorf("expected 1 quadratic constraint; received %v (%v in model.QConstraints)", numQConstrs, len(model.QConstraints))
+	}
+
+	name, err := model.QConstraints[0].QCName()
+	if err != nil {
+		t.Errorf("unexpected error retrieving QCName: %v", err)
+	}
+
+	if name != "qc1" {
+		t.Errorf("expected remaining constraint to be %v; received %v", "qc1", name)
+	}
+}
+
+/*
+TestQConstr_DelQConstrs2
+Description:
+
+	Verifies that DelQConstrs() returns an error instead of panicking when one of
+	the given constraints is nil, and that nothing is deleted.
+*/
+func TestQConstr_DelQConstrs2(t *testing.T) {
+	// Constants
+	testName := "qconstr-delqconstrs2"
+
+	env, err := gurobi.NewEnv(testName + ".log")
+	if err != nil {
+		t.Fatalf("There was an issue creating the new Env: %v", err)
+	}
+	defer os.Remove(testName + ".log")
+	defer env.Free()
+
+	model, err := gurobi.NewModel(testName, env)
+	if err != nil {
+		t.Fatalf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.CONTINUOUS, 0.0, -gurobi.INFINITY, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+
+	expr := gurobi.QuadExpr{}
+	expr.AddQTerm(x, x, 1.0)
+	qc0, err := model.AddQConstr(&expr, gurobi.SenseLessThan, 1.0, "qc0")
+	if err != nil {
+		t.Errorf("unexpected error adding qc0: %v", err)
+	}
+
+	// Algorithm
+	err = model.DelQConstrs([]*gurobi.QConstr{qc0, nil})
+
+	// Test
+	if err == nil {
+		t.Errorf("expected an error for the nil constraint, but received none!")
+	}
+
+	if qc0.Index != 0 || len(model.QConstraints) != 1 {
+		t.Errorf("expected qc0 to be kept; received index %v and %v constraints", qc0.Index, len(model.QConstraints))
+	}
+}
@@ -0,0 +1,168 @@
+package gurobi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+sensitivity_test.go
+Description:
+	Tests the sensitivity report of the gurobi package.
+*/
+
+/*
+TestSensitivity_BuildSensitivityReport1
+Description:
+
+	Verifies that BuildSensitivityReport() returns an error when the model is not initialized.
+*/
+func TestSensitivity_BuildSensitivityReport1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	_, err := model0.BuildSensitivityReport()
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestSensitivity_BuildSensitivityReport2
+Description:
+
+	Minimizes x + 2y subject to
+		c1: x + y >= 2
+		c2: x <= 5
+	with x, y >= 0. At the optimum x = 2, y = 0, so
+	  - c1 has dual value 1 and stays binding for right hand sides up to 5,
+	  - y has reduced cost 1,
+	  - the cost of x may rise to 2 before the basis changes.
+*/
+func TestSensitivity_BuildSensitivityReport2(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "sensitivity-buildsensitivityreport2")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	y, _ := model.AddVar(gurobi.CONTINUOUS, 2.0, 0.0, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+	model.AddConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, gurobi.SenseGreaterThan, 2.0, "c1")
+	model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseLessThan, 5.0, "c2")
+
+	if err := model.Optimize(); err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	// Algorithm
+	report, err := model.BuildSensitivityReport()
+	if err != nil {
+		t.Fatalf("unexpected error building the report: %v", err)
+	}
+
+	// Test
+	if len(report.Vars) != 2 || len(report.Constrs) != 2 {
+		t.Fatalf("expected 2 variables and 2 constraints; received %v and %v", len(report.Vars), len(report.Constrs))
+	}
+
+	if report.Vars[1].Name != "y" || math.Abs(report.Vars[1].RC-1.0) > 1e-6 {
+		t.Errorf("expected y to have reduced cost 1; received %+v", report.Vars[1])
+	}
+
+	if math.Abs(report.Vars[0].SAObjUp-2.0) > 1e-6 {
+		t.Errorf("expected SAObjUp of x to be 2; received %+v", report.Vars[0])
+	}
+
+	c1 := report.Constrs[0]
+	if c1.Name != "c1" || math.Abs(c1.Pi-1.0) > 1e-6 {
+		t.Errorf("expected c1 to have dual value 1; received %+v", c1)
+	}
+
+	if math.Abs(c1.SARHSUp-5.0) > 1e-6 {
+		t.Errorf("expected SARHSUp of c1 to be 5; received %+v", c1)
+	}
+
+	if report.Constrs[1].CBasis != gurobi.BASIC {
+		t.Errorf("expected c2 to be basic; received %+v", report.Constrs[1])
+	}
+}
+
+/*
+TestSensitivity_WriteCSV1
+Description:
+
+	Verifies the CSV output of a small report.
+*/
+func TestSensitivity_WriteCSV1(t *testing.T) {
+	// Constants
+	report := gurobi.SensitivityReport{
+		ObjVal: 2.0,
+		Vars: []gurobi.VarSensitivity{
+			{Index: 0, Name: "x", X: 2, RC: 0, VBasis: gurobi.BASIC, Obj: 1, SAObjLow: 0, SAObjUp: 2, SALBLow: -1e+100, SALBUp: 2, SAUBLow: 2, SAUBUp: 1e+100},
+		},
+		Constrs: []gurobi.ConstrSensitivity{
+			{Index: 0, Name: "c1", Pi: 1, Slack: 0, CBasis: gurobi.NONBASIC_LOWER, RHS: 2, SARHSLow: 0, SARHSUp: 5},
+		},
+	}
+
+	// Test
+	var vars bytes.Buffer
+	if err := report.WriteVarsCSV(&vars); err != nil {
+		t.Fatalf("unexpected error writing the variables: %v", err)
+	}
+
+	expectedVars := "index,name,x,rc,vbasis,obj,saObjLow,saObjUp,saLBLow,saLBUp,saUBLow,saUBUp\n" +
+		"0,x,2,0,0,1,0,2,-1e+100,2,2,1e+100\n"
+	if vars.String() != expectedVars {
+		t.Errorf("expected %q; received %q", expectedVars, vars.String())
+	}
+
+	var constrs bytes.Buffer
+	if err := report.WriteConstrsCSV(&constrs); err != nil {
+		t.Fatalf("unexpected error writing the constraints: %v", err)
+	}
+
+	expectedConstrs := "index,name,pi,slack,cbasis,rhs,saRHSLow,saRHSUp\n" +
+		"0,c1,1,0,-1,2,0,5\n"
+	if constrs.String() != expectedConstrs {
+		t.Errorf("expected %q; received %q", expectedConstrs, constrs.String())
+	}
+}
+
+/*
+TestSensitivity_WriteJSON1
+Description:
+
+	Verifies that the JSON output of a report can be decoded into an equal report.
+*/
+func TestSensitivity_WriteJSON1(t *testing.T) {
+	// Constants
+	report := gurobi.SensitivityReport{
+		ObjVal:  2.0,
+		Vars:    []gurobi.VarSensitivity{{Index: 0, Name: "x", X: 2, SAObjUp: 2}},
+		Constrs: []gurobi.ConstrSensitivity{{Index: 0, Name: "c1", Pi: 1, SARHSUp: 5}},
+	}
+
+	// Algorithm
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatalf("unexpected error writing JSON: %v", err)
+	}
+
+	var decoded gurobi.SensitivityReport
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("unexpected error decoding JSON: %v", err)
+	}
+
+	// Test
+	if decoded.ObjVal != report.ObjVal || decoded.Vars[0] != report.Vars[0] || decoded.Constrs[0] != report.Constrs[0] {
+		t.Errorf("expected %+v; received %+v", report, decoded)
+	}
+}
@@ -0,0 +1,204 @@
+package gurobi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+sos_test.go
+Description:
+	Tests the SOS constraint functions of the gurobi package.
+*/
+
+/*
+createSOSTestModel
+Description:
+
+	Creates a model which maximizes x + y + z with 0 <= x, y, z <= 1.
+*/
+func createSOSTestModel(t *testing.T, name string) (*gurobi.Model, []*gurobi.Var) {
+	model := newTestModel(t, name)
+
+	vars := make([]*gurobi.Var, 3)
+	for i := range vars {
+		var err error
+		vars[i], err = model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 1.0, "", []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Fatalf("There was an issue adding a variable: %v", err)
+		}
+	}
+	if err := model.SetIntAttr("ModelSense", gurobi.MAXIMIZE); err != nil {
+		t.Fatalf("unexpected error setting the model sense: %v", err)
+	}
+
+	return model, vars
+}
+
+/*
+TestSOS_AddSOS1
+Description:
+
+	Verifies that an SOS1 constraint over x, y and z only lets one of them be nonzero
+	(optimal objective 1) and that GetSOS() returns its members.
+*/
+func TestSOS_AddSOS1(t *testing.T) {
+	// Constants
+	model, vars := createSOSTestModel(t, "sos-addsos1")
+
+	sos, err := model.AddSOS(gurobi.SOS_TYPE1, vars, []float64{1, 2, 3})
+	if err != nil {
+		t.Errorf("unexpected error adding SOS: %v", err)
+	}
+
+	// Check retrieval
+	sosType, members, weights, err := model.GetSOS(sos)
+	if err != nil {
+		t.Errorf("unexpected error retrieving SOS: %v", err)
+	}
+
+	if sosType != gurobi.SOS_TYPE1 {
+		t.Errorf("expected SOS type %v; received %v", gurobi.SOS_TYPE1, sosType)
+	}
+
+	if len(members) != 3 || len(weights) != 3 {
+		t.Errorf("expected 3 members and weights; received %v and %v", len(members), len(weights))
+	} else {
+		for i := range members {
+			if members[i].Index != vars[i].Index {
+				t.Errorf("expected member %v to be variable %v; received %v", i, vars[i].Index, members[i].Index)
+			}
+			if weights[i] != float64(i+1) {
+				t.Errorf("expected weight %v to be %v; received %v", i, i+1, weights[i])
+			}
+		}
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-1.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 1.0, objVal)
+	}
+}
+
+/*
+TestSOS_AddSOSs1
+Description:
+
+	Verifies that AddSOSs() returns an error when the number of types
+	and member lists do not match.
+*/
+func TestSOS_AddSOSs1(t *testing.T) {
+	// Constants
+	model, vars := createSOSTestModel(t, "sos-addsoss1")
+
+	// Test
+	_, err := model.AddSOSs(
+		[]int{gurobi.SOS_TYPE1, gurobi.SOS_TYPE2},
+		[][]*gurobi.Var{vars},
+		[][]float64{{1, 2, 3}},
+	)
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != (gurobi.MismatchedLengthError{
+			Length1: 2,
+			Length2: 1,
+			Name1:   "sosTypes",
+			Name2:   "vars",
+		}).Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestSOS_AddSOSs2
+Description:
+
+	Adds an SOS2 constraint over x, y, z in a batch. Only two consecutive members
+	can be nonzero, so the optimal objective should be 2.
+*/
+func TestSOS_AddSOSs2(t *testing.T) {
+	// Constants
+	model, vars := createSOSTestModel(t, "sos-addsoss2")
+
+	soss, err := model.AddSOSs(
+		[]int{gurobi.SOS_TYPE2},
+		[][]*gurobi.Var{vars},
+		[][]float64{{1, 2, 3}},
+	)
+	if err != nil {
+		t.Errorf("unexpected error adding SOSs: %v", err)
+	}
+
+	if len(soss) != 1 || len(model.SOSConstraints) != 1 {
+		t.Errorf("expected 1 SOS constraint; received %v (%v in model)", len(soss), len(model.SOSConstraints))
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-2.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 2.0, objVal)
+	}
+}
+
+/*
+TestSOS_DelSOSs1
+Description:
+
+	Verifies that deleting an SOS1 constraint removes its restriction
+	(optimal objective 3) and invalidates its handle.
+*/
+func TestSOS_DelSOSs1(t *testing.T) {
+	// Constants
+	model, vars := createSOSTestModel(t, "sos-delsoss1")
+
+	sos, err := model.AddSOS(gurobi.SOS_TYPE1, vars, []float64{1, 2, 3})
+	if err != nil {
+		t.Errorf("unexpected error adding SOS: %v", err)
+	}
+
+	// Delete
+	if err := model.DelSOSs([]*gurobi.SOS{sos}); err != nil {
+		t.Errorf("unexpected error deleting SOS: %v", err)
+	}
+
+	if sos.Index != -1 {
+		t.Errorf("expected deleted SOS to have index -1; received %v", sos.Index)
+	}
+
+	// Check solution
+	if objVal := optimizeAndGetObjective(t, model); math.Abs(objVal-3.0) > 1e-6 {
+		t.Errorf("expected objective %v; received %v", 3.0, objVal)
+	}
+}
+
+/*
+TestSOS_DelSOSs2
+Description:
+
+	Verifies that DelSOSs() returns an error instead of panicking when one of
+	the given constraints is nil, and that nothing is deleted.
+*/
+func TestSOS_DelSOSs2(t *testing.T) {
+	// Constants
+	model, vars := createSOSTestModel(t, "sos-delsoss2")
+
+	sos, err := model.AddSOS(gurobi.SOS_TYPE1, vars, []float64{1, 2, 3})
+	if err != nil {
+		t.Errorf("unexpected error adding SOS: %v", err)
+	}
+
+	// Algorithm
+	err = model.DelSOSs([]*gurobi.SOS{sos, nil})
+
+	// Test
+	if err == nil {
+		t.Errorf("expected an error for the nil SOS, but received none!")
+	}
+
+	if sos.Index != 0 || len(model.SOSConstraints) != 1 {
+		t.Errorf("expected the SOS to be kept; received index %v and %v constraints", sos.Index, len(model.SOSConstraints))
+	}
+}
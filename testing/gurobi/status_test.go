@@ -0,0 +1,154 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+status_test.go
+Description:
+	Tests the Status type of the gurobi package.
+*/
+
+/*
+TestStatus_String1
+Description:
+
+	Verifies the names of a few known status codes and of an unknown one.
+*/
+func TestStatus_String1(t *testing.T) {
+	// Constants
+	expected := map[gurobi.Status]string{
+		gurobi.LOADED:     "LOADED",
+		gurobi.OPTIMAL:    "OPTIMAL",
+		gurobi.TIME_LIMIT: "TIME_LIMIT",
+		gurobi.MEM_LIMIT:  "MEM_LIMIT",
+		gurobi.Status(99): "Status(99)",
+	}
+
+	// Test
+	for status, name := range expected {
+		if status.String() != name {
+			t.Errorf("expected status %d to be named %v; received %v", int32(status), name, status.String())
+		}
+	}
+}
+
+/*
+TestStatus_Constants1
+Description:
+
+	Verifies that the status constants can be compared with both a Status and the
+	int32 value of the Status attribute.
+*/
+func TestStatus_Constants1(t *testing.T) {
+	// Constants
+	var statusAsInt32 int32 = 2
+	var status gurobi.Status = 2
+
+	// Test
+	if statusAsInt32 != gurobi.OPTIMAL || status != gurobi.OPTIMAL {
+		t.Errorf("expected status code 2 to be OPTIMAL")
+	}
+}
+
+/*
+TestStatus_HasSolution1
+Description:
+
+	Verifies that only OPTIMAL and SUBOPTIMAL guarantee a solution.
+*/
+func TestStatus_HasSolution1(t *testing.T) {
+	for status := gurobi.Status(gurobi.LOADED); status <= gurobi.MEM_LIMIT; status++ {
+		expected := status == gurobi.OPTIMAL || status == gurobi.SUBOPTIMAL
+		if status.HasSolution() != expected {
+			t.Errorf("expected %v.HasSolution() to be %v", status, expected)
+		}
+	}
+}
+
+/*
+TestStatus_IsTerminal1
+Description:
+
+	Verifies that LOADED, INPROGRESS and unknown codes are not terminal while the other codes are.
+*/
+func TestStatus_IsTerminal1(t *testing.T) {
+	for status := gurobi.Status(gurobi.LOADED); status <= gurobi.MEM_LIMIT; status++ {
+		expected := status != gurobi.LOADED && status != gurobi.INPROGRESS
+		if status.IsTerminal() != expected {
+			t.Errorf("expected %v.IsTerminal() to be %v", status, expected)
+		}
+	}
+
+	if gurobi.Status(0).IsTerminal() {
+		t.Errorf("expected an unknown status not to be terminal")
+	}
+}
+
+/*
+TestModel_Status1
+Description:
+
+	Verifies that Status() returns an error when the model is not initialized.
+*/
+func TestModel_Status1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Algorithm
+	_, err := model0.Status()
+	if err == nil {
+		t.Errorf("expected an error, but received none!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestModel_Status2
+Description:
+
+	Verifies the status of a model before optimization (LOADED) and after optimizing
+	an infeasible model (INFEASIBLE).
+*/
+func TestModel_Status2(t *testing.T) {
+	// Constants
+	model := newTestModel(t, "testmodel-status2")
+
+	x, _ := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if _, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.SenseGreaterThan, 2.0, "c0"); err != nil {
+		t.Errorf("unexpected error adding constraint: %v", err)
+	}
+
+	// Test
+	status, err := model.Status()
+	if err != nil {
+		t.Errorf("unexpected error retrieving the status: %v", err)
+	}
+
+	if status != gurobi.LOADED {
+		t.Errorf("expected status LOADED before optimization; received %v", status)
+	}
+
+	if err := model.Params().SetInt("DualReductions", 0); err != nil {
+		t.Errorf("unexpected error setting DualReductions: %v", err)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("unexpected error while optimizing: %v", err)
+	}
+
+	status, err = model.Status()
+	if err != nil {
+		t.Errorf("unexpected error retrieving the status: %v", err)
+	}
+
+	if status != gurobi.INFEASIBLE || !status.IsTerminal() || status.HasSolution() {
+		t.Errorf("expected status INFEASIBLE after optimization; received %v", status)
+	}
+}
@@ -7,11 +7,14 @@ Description:
 */
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/MatProGo-dev/Gurobi.go/gurobi"
 	"github.com/MatProGo-dev/Gurobi.go/mpgSolver"
 	"github.com/MatProGo-dev/MatProInterface.go/optim"
 	"gonum.org/v1/gonum/mat"
+	"math"
 	"os"
 	"testing"
 )
@@ -227,3 +230,779 @@ func TestGurobiSolver_Solve1(t *testing.T) {
 		fmt.Printf("  x_1=%.4f, x_2=%.4f\n", sol.Values[0], sol.Values[1])
 	}
 }
+
+/*
+TestGurobiSolver_AddConstraint2
+Description:
+
+	Adds a scalar quadratic constraint (x' * x <= 1) to the model and verifies that
+	it becomes a quadratic constraint in the gurobi model.
+*/
+func TestGurobiSolver_AddConstraint2(t *testing.T) {
+	// Constants
+	modelName := "addconstraint2-test"
+	m := optim.NewModel(modelName)
+	x := m.AddVariableVector(2)
+
+	gs := mpgSolver.NewGurobiSolver("solvertest-addconstraint2")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	// Add variables to
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected issue adding variables to gurobi solver's model: %v", err)
+	}
+
+	// Create quadratic constraint
+	qe1 := optim.ScalarQuadraticExpression{
+		Q: optim.Identity(x.Len()),
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{0.0, 0.0}),
+		C: 0.0,
+	}
+	sc1 := optim.ScalarConstraint{
+		LeftHandSide:  qe1,
+		RightHandSide: optim.K(1.0),
+		Sense:         optim.SenseLessThanEqual,
+	}
+
+	// Test Adding Constraints
+	err = gs.AddConstraint(sc1)
+	if err != nil {
+		t.Errorf("There was an issue adding the quadratic constraint to the model: %v", err)
+	}
+
+	if len(gs.CurrentModel.QConstraints) != 1 {
+		t.Errorf("Expected 1 quadratic constraint in the model; received %v", len(gs.CurrentModel.QConstraints))
+	}
+
+	if len(gs.CurrentModel.Constraints) != 0 {
+		t.Errorf("Expected 0 linear constraints in the model; received %v", len(gs.CurrentModel.Constraints))
+	}
+}
+
+/*
+TestGurobiSolver_Solve2
+Description:
+
+	Tests that a model with a quadratic constraint can be solved. We minimize
+	-x_1 - x_2 over the disk x' * x + 0.5 <= 1.5 (i.e. the unit disk), which
+	should have optimal objective -sqrt(2).
+*/
+func TestGurobiSolver_Solve2(t *testing.T) {
+	// Constants
+	exampleName := "testgurobisolver-solve2"
+
+	// Create an empty model.
+	model := optim.NewModel(exampleName + ".model")
+
+	// Add varibles
+	x := model.AddVariableVector(2)
+
+	// Create constraints
+	qe1 := optim.ScalarQuadraticExpression{
+		Q: optim.Identity(x.Len()),
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{0.0, 0.0}),
+		C: 0.5,
+	}
+	err := model.AddConstraint(
+		optim.ScalarConstraint{
+			LeftHandSide:  qe1,
+			RightHandSide: optim.K(1.5),
+			Sense:         optim.SenseLessThanEqual,
+		},
+	)
+	if err != nil {
+		t.Errorf("there was an issue adding the quadratic constraint: %v", err)
+	}
+
+	// Set Objective function
+	obj := optim.ScalarLinearExpr{
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{-1.0, -1.0}),
+		C: 0.0,
+	}
+
+	err = model.SetObjective(obj, optim.SenseMinimize)
+	if err != nil {
+		t.Errorf("There was an issue setting the objective for the model: %v", err)
+	}
+
+	// Solve the above model with GurobiSolver
+	sol, gs, err := mpgSolver.Solve(*model)
+	if err != nil {
+		t.Errorf("error in solving model: %v", err)
+	}
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	// Check solution
+	if sol.Status != gurobi.OPTIMAL {
+		t.Errorf("Optimization status was not optimal! (Received %v)", sol.Status)
+	}
+
+	if math.Abs(sol.Objective+math.Sqrt2) > 1e-5 {
+		t.Errorf("Expected objective %v; received %v", -math.Sqrt2, sol.Objective)
+	}
+}
+
+/*
+TestGurobiSolver_SetTimeLimit1
+Description:
+
+	Sets a time limit of 0 seconds through the solver and verifies that it reaches
+	the model: the limit can be read back and the optimization stops because of it.
+*/
+func TestGurobiSolver_SetTimeLimit1(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-settimelimit1")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	// Create a small binary program.
+	model := optim.NewModel("testgurobisolver-settimelimit1.model")
+	x := model.AddVariableVectorClassic(2, 0.0, 1.0, optim.Binary)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	obj := optim.ScalarLinearExpr{
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{-1.0, -1.0}),
+		C: 0.0,
+	}
+	err = gs.SetObjective(optim.Objective{ScalarExpression: obj, Sense: optim.SenseMinimize})
+	if err != nil {
+		t.Errorf("unexpected error setting the objective: %v", err)
+	}
+
+	// Algorithm
+	err = gs.SetTimeLimit(0.0)
+	if err != nil {
+		t.Errorf("unexpected error setting the time limit: %v", err)
+	}
+
+	// Test
+	limit, err := gs.GetTimeLimit()
+	if err != nil {
+		t.Errorf("unexpected error getting the time limit: %v", err)
+	}
+
+	if limit != 0.0 {
+		t.Errorf("expected time limit 0; received %v", limit)
+	}
+
+	if err := gs.CurrentModel.Optimize(); err != nil {
+		t.Errorf("unexpected error while optimizing: %v", err)
+	}
+
+	status, err := gs.CurrentModel.GetIntAttr(gurobi.INT_ATTR_STATUS)
+	if err != nil {
+		t.Errorf("unexpected error retrieving the status: %v", err)
+	}
+
+	if status != gurobi.TIME_LIMIT {
+		t.Errorf("expected status %v (TIME_LIMIT); received %v", gurobi.TIME_LIMIT, status)
+	}
+}
+
+/*
+TestGurobiSolver_SetTimeLimit2
+Description:
+
+	Verifies that the gurobi.Error behind a failed SetTimeLimit() call is preserved,
+	so that callers can inspect it with errors.Is.
+*/
+func TestGurobiSolver_SetTimeLimit2(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-settimelimit2")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	// Algorithm
+	err := gs.SetTimeLimit(-1.0)
+
+	// Test
+	if !errors.Is(err, gurobi.ErrValueOutOfRange) {
+		t.Errorf("expected ErrValueOutOfRange; received %v", err)
+	}
+}
+
+/*
+TestGurobiSolver_OptimizeContext1
+Description:
+
+	Verifies that OptimizeContext() solves a small integer program when the
+	context is never cancelled. Maximizing x_1 + x_2 with 0 <= x_i <= 10 should give 20.
+*/
+func TestGurobiSolver_OptimizeContext1(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-optimizecontext1")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	model := optim.NewModel("testgurobisolver-optimizecontext1.model")
+	x := model.AddVariableVectorClassic(2, 0.0, 10.0, optim.Integer)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	obj := optim.ScalarLinearExpr{
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{1.0, 1.0}),
+		C: 0.0,
+	}
+	err = gs.SetObjective(optim.Objective{ScalarExpression: obj, Sense: optim.SenseMaximize})
+	if err != nil {
+		t.Errorf("unexpected error setting the objective: %v", err)
+	}
+
+	// Algorithm
+	sol, err := gs.OptimizeContext(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	// Test
+	if sol.Status != gurobi.OPTIMAL {
+		t.Errorf("Optimization status was not optimal! (Received %v)", sol.Status)
+	}
+
+	if math.Abs(sol.Objective-20.0) > 1e-6 {
+		t.Errorf("Expected objective %v; received %v", 20.0, sol.Objective)
+	}
+}
+
+/*
+TestGurobiSolver_OptimizeContext2
+Description:
+
+	Verifies that the error returned by OptimizeContext() for a cancelled context
+	wraps context.Canceled.
+*/
+func TestGurobiSolver_OptimizeContext2(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-optimizecontext2")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Algorithm
+	_, err := gs.OptimizeContext(ctx)
+
+	// Test
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled; received %v", err)
+	}
+}
+
+/*
+TestGurobiSolver_ComputeIIS1
+Description:
+
+	Builds the infeasible model
+		constraint0: x >= [2, 0]
+		constraint1: x <= [1, 5]
+	with -10 <= x_i <= 10 and verifies that the IIS found when optimizing with
+	ComputeIISOnInfeasible contains exactly the first element of each constraint.
+*/
+func TestGurobiSolver_ComputeIIS1(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-computeiis1")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+	gs.ComputeIISOnInfeasible = true
+
+	model := optim.NewModel("testgurobisolver-computeiis1.model")
+	x := model.AddVariableVectorClassic(2, -10.0, 10.0, optim.Continuous)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	err = gs.AddConstraint(x.GreaterEq(*mat.NewVecDense(2, []float64{2.0, 0.0})))
+	if err != nil {
+		t.Fatalf("unexpected error adding constraint 0: %v", err)
+	}
+
+	err = gs.AddConstraint(x.LessEq(*mat.NewVecDense(2, []float64{1.0, 5.0})))
+	if err != nil {
+		t.Fatalf("unexpected error adding constraint 1: %v", err)
+	}
+
+	// Algorithm
+	_, err = gs.Optimize()
+
+	// Test
+	var infeasibleErr mpgSolver.InfeasibleError
+	if !errors.As(err, &infeasibleErr) {
+		t.Fatalf("expected an InfeasibleError; received %v", err)
+	}
+
+	origins := infeasibleErr.Diagnosis.Constraints
+	if len(origins) != 2 {
+		t.Fatalf("expected 2 constraints in the IIS; received %v", origins)
+	}
+
+	for i, origin := range origins {
+		if origin.Index != i || origin.Element != 0 {
+			t.Errorf("expected the IIS to contain element 0 of constraint %v; received %v", i, origin.RowName())
+		}
+	}
+
+	if len(infeasibleErr.Diagnosis.LowerBoundIDs) != 0 || len(infeasibleErr.Diagnosis.UpperBoundIDs) != 0 {
+		t.Errorf(
+			"expected no variable bounds in the IIS; received %v and %v",
+			infeasibleErr.Diagnosis.LowerBoundIDs, infeasibleErr.Diagnosis.UpperBoundIDs,
+		)
+	}
+}
+
+/*
+TestGurobiSolver_ComputeIIS2
+Description:
+
+	Verifies that the rows added by AddConstraint() are recorded and named after
+	the position of the constraint that created them.
+*/
+func TestGurobiSolver_ComputeIIS2(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-computeiis2")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	model := optim.NewModel("testgurobisolver-computeiis2.model")
+	x := model.AddVariableVectorClassic(2, -10.0, 10.0, optim.Continuous)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	// Algorithm
+	err = gs.AddConstraint(x.LessEq(*mat.NewVecDense(2, []float64{1.0, 5.0})))
+	if err != nil {
+		t.Fatalf("unexpected error adding the constraint: %v", err)
+	}
+
+	// Test
+	if gs.NumConstraints != 1 {
+		t.Errorf("expected 1 constraint to be recorded; received %v", gs.NumConstraints)
+	}
+
+	if len(gs.ConstrOrigins) != 2 {
+		t.Fatalf("expected 2 recorded rows; received %v", len(gs.ConstrOrigins))
+	}
+
+	for i, gurobiConstr := range gs.CurrentModel.Constraints {
+		origin, tf := gs.ConstrOrigins[gurobiConstr]
+		if !tf {
+			t.Errorf("row %v was not recorded", i)
+			continue
+		}
+
+		expected := fmt.Sprintf("constraint0[%v]", i)
+		if origin.RowName() != expected {
+			t.Errorf("expected row name %v; received %v", expected, origin.RowName())
+		}
+	}
+}
+
+/*
+TestGurobiSolver_ComputeIIS3
+Description:
+
+	Builds the infeasible model
+		constraint0: x_1 >= 2
+		constraint1: x_1 <= 1
+	where x_0 is free and minimized, so that presolve (with the default DualReductions)
+	may only report INF_OR_UNBD. Verifies that Optimize() with ComputeIISOnInfeasible
+	still returns an InfeasibleError and an infeasible status.
+*/
+func TestGurobiSolver_ComputeIIS3(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-computeiis3")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+	gs.ComputeIISOnInfeasible = true
+
+	model := optim.NewModel("testgurobisolver-computeiis3.model")
+	x := model.AddVariableVectorClassic(2, -optim.INFINITY, optim.INFINITY, optim.Continuous)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	err = gs.AddConstraint(x.AtVec(1).GreaterEq(2.0))
+	if err != nil {
+		t.Fatalf("unexpected error adding constraint 0: %v", err)
+	}
+
+	err = gs.AddConstraint(x.AtVec(1).LessEq(1.0))
+	if err != nil {
+		t.Fatalf("unexpected error adding constraint 1: %v", err)
+	}
+
+	obj := optim.ScalarLinearExpr{
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{1.0, 0.0}),
+		C: 0.0,
+	}
+	err = gs.SetObjective(optim.Objective{ScalarExpression: obj, Sense: optim.SenseMinimize})
+	if err != nil {
+		t.Errorf("unexpected error setting the objective: %v", err)
+	}
+
+	// Algorithm
+	sol, err := gs.Optimize()
+
+	// Test
+	var infeasibleErr mpgSolver.InfeasibleError
+	if !errors.As(err, &infeasibleErr) {
+		t.Fatalf("expected an InfeasibleError; received %v", err)
+	}
+
+	if sol.Status != optim.OptimizationStatus_INFEASIBLE {
+		t.Errorf("expected status INFEASIBLE; received %v", sol.Status)
+	}
+
+	if len(infeasibleErr.Diagnosis.Constraints) != 2 {
+		t.Errorf("expected 2 constraints in the IIS; received %v", infeasibleErr.Diagnosis.Constraints)
+	}
+}
+
+/*
+TestGurobiSolver_ComputeIIS4
+Description:
+
+	Minimizes the free variable x_0 subject to x_1 <= 1. The model is unbounded,
+	so Optimize() with ComputeIISOnInfeasible should not report an InfeasibleError.
+*/
+func TestGurobiSolver_ComputeIIS4(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-computeiis4")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+	gs.ComputeIISOnInfeasible = true
+
+	model := optim.NewModel("testgurobisolver-computeiis4.model")
+	x := model.AddVariableVectorClassic(2, -optim.INFINITY, optim.INFINITY, optim.Continuous)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	err = gs.AddConstraint(x.AtVec(1).LessEq(1.0))
+	if err != nil {
+		t.Fatalf("unexpected error adding the constraint: %v", err)
+	}
+
+	obj := optim.ScalarLinearExpr{
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{1.0, 0.0}),
+		C: 0.0,
+	}
+	err = gs.SetObjective(optim.Objective{ScalarExpression: obj, Sense: optim.SenseMinimize})
+	if err != nil {
+		t.Errorf("unexpected error setting the objective: %v", err)
+	}
+
+	// Algorithm
+	sol, err := gs.Optimize()
+
+	// Test
+	var infeasibleErr mpgSolver.InfeasibleError
+	if errors.As(err, &infeasibleErr) {
+		t.Errorf("expected no InfeasibleError for an unbounded model; received %v", err)
+	}
+
+	if sol.Status == optim.OptimizationStatus_INFEASIBLE {
+		t.Errorf("expected the unbounded model not to be reported as infeasible")
+	}
+}
+
+/*
+TestGurobiSolver_OptimizeExtended1
+Description:
+
+	Minimizes x_1 + 2 x_2 subject to x >= [2, 0] with -10 <= x_i <= 10.
+	Both rows are binding at the optimum x = [2, 0], so their duals are the
+	objective coefficients 1 and 2 and their slacks are 0.
+*/
+func TestGurobiSolver_OptimizeExtended1(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-optimizeextended1")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	model := optim.NewModel("testgurobisolver-optimizeextended1.model")
+	x := model.AddVariableVectorClassic(2, -10.0, 10.0, optim.Continuous)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	err = gs.AddConstraint(x.GreaterEq(*mat.NewVecDense(2, []float64{2.0, 0.0})))
+	if err != nil {
+		t.Fatalf("unexpected error adding the constraint: %v", err)
+	}
+
+	obj := optim.ScalarLinearExpr{
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{1.0, 2.0}),
+		C: 0.0,
+	}
+	err = gs.SetObjective(optim.Objective{ScalarExpression: obj, Sense: optim.SenseMinimize})
+	if err != nil {
+		t.Errorf("unexpected error setting the objective: %v", err)
+	}
+
+	// Algorithm
+	sol, err := gs.OptimizeExtended()
+	if err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	// Test
+	if sol.Status != gurobi.OPTIMAL {
+		t.Errorf("Optimization status was not optimal! (Received %v)", sol.Status)
+	}
+
+	if math.Abs(sol.Objective-2.0) > 1e-6 {
+		t.Errorf("Expected objective %v; received %v", 2.0, sol.Objective)
+	}
+
+	expectedDuals := map[mpgSolver.ConstraintKey]float64{
+		{Index: 0, Element: 0}: 1.0,
+		{Index: 0, Element: 1}: 2.0,
+	}
+	for key, expected := range expectedDuals {
+		if math.Abs(sol.Duals[key]-expected) > 1e-6 {
+			t.Errorf("expected dual %v for %v; received %v", expected, key, sol.Duals[key])
+		}
+
+		if math.Abs(sol.Slacks[key]) > 1e-6 {
+			t.Errorf("expected slack 0 for %v; received %v", key, sol.Slacks[key])
+		}
+
+		if _, tf := sol.Origins[key]; !tf {
+			t.Errorf("expected the origin of %v to be recorded", key)
+		}
+	}
+
+	if len(sol.ReducedCosts) != 2 {
+		t.Errorf("expected 2 reduced costs; received %v", sol.ReducedCosts)
+	}
+
+	if sol.Runtime < 0.0 {
+		t.Errorf("expected a nonnegative runtime; received %v", sol.Runtime)
+	}
+}
+
+/*
+TestGurobiSolver_OptimizeExtended2
+Description:
+
+	Verifies that the extended solution of a small integer program has no duals
+	but does report the MIP statistics.
+*/
+func TestGurobiSolver_OptimizeExtended2(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-optimizeextended2")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	model := optim.NewModel("testgurobisolver-optimizeextended2.model")
+	x := model.AddVariableVectorClassic(2, 0.0, 10.0, optim.Integer)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	err = gs.AddConstraint(x.LessEq(*mat.NewVecDense(2, []float64{2.5, 3.5})))
+	if err != nil {
+		t.Fatalf("unexpected error adding the constraint: %v", err)
+	}
+
+	obj := optim.ScalarLinearExpr{
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{1.0, 1.0}),
+		C: 0.0,
+	}
+	err = gs.SetObjective(optim.Objective{ScalarExpression: obj, Sense: optim.SenseMaximize})
+	if err != nil {
+		t.Errorf("unexpected error setting the objective: %v", err)
+	}
+
+	// Algorithm
+	sol, err := gs.OptimizeExtended()
+	if err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	// Test
+	if math.Abs(sol.Objective-5.0) > 1e-6 {
+		t.Errorf("Expected objective %v; received %v", 5.0, sol.Objective)
+	}
+
+	if len(sol.Duals) != 0 || len(sol.ReducedCosts) != 0 {
+		t.Errorf("expected no duals or reduced costs for a MIP; received %v and %v", sol.Duals, sol.ReducedCosts)
+	}
+
+	if len(sol.Slacks) != 2 {
+		t.Errorf("expected 2 slacks; received %v", sol.Slacks)
+	}
+
+	if math.Abs(sol.ObjBound-5.0) > 1e-6 {
+		t.Errorf("expected ObjBound %v; received %v", 5.0, sol.ObjBound)
+	}
+}
+
+/*
+TestGurobiSolver_OptimizeExtended3
+Description:
+
+	Minimizes -x_1 - x_2 over the unit disk x' * x <= 1. The model is continuous but has
+	a quadratic constraint, so without the QCPDual parameter Gurobi computes no duals or
+	reduced costs. Verifies that the extended solution is still returned, with empty
+	Duals and ReducedCosts.
+*/
+func TestGurobiSolver_OptimizeExtended3(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-optimizeextended3")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	model := optim.NewModel("testgurobisolver-optimizeextended3.model")
+	x := model.AddVariableVectorClassic(2, -10.0, 10.0, optim.Continuous)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	qe1 := optim.ScalarQuadraticExpression{
+		Q: optim.Identity(x.Len()),
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{0.0, 0.0}),
+		C: 0.0,
+	}
+	err = gs.AddConstraint(optim.ScalarConstraint{
+		LeftHandSide:  qe1,
+		RightHandSide: optim.K(1.0),
+		Sense:         optim.SenseLessThanEqual,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error adding the quadratic constraint: %v", err)
+	}
+
+	obj := optim.ScalarLinearExpr{
+		X: x,
+		L: *mat.NewVecDense(x.Len(), []float64{-1.0, -1.0}),
+		C: 0.0,
+	}
+	err = gs.SetObjective(optim.Objective{ScalarExpression: obj, Sense: optim.SenseMinimize})
+	if err != nil {
+		t.Errorf("unexpected error setting the objective: %v", err)
+	}
+
+	// Algorithm
+	sol, err := gs.OptimizeExtended()
+	if err != nil {
+		t.Fatalf("unexpected error while optimizing: %v", err)
+	}
+
+	// Test
+	if math.Abs(sol.Objective+math.Sqrt(2.0)) > 1e-4 {
+		t.Errorf("Expected objective %v; received %v", -math.Sqrt(2.0), sol.Objective)
+	}
+
+	if len(sol.Duals) != 0 {
+		t.Errorf("expected no duals without QCPDual; received %v", sol.Duals)
+	}
+
+	if len(sol.ReducedCosts) != 0 {
+		t.Errorf("expected no reduced costs without QCPDual; received %v", sol.ReducedCosts)
+	}
+
+	key := mpgSolver.ConstraintKey{Index: 0, Element: -1}
+	if _, tf := sol.Slacks[key]; !tf {
+		t.Errorf("expected the slack of %v to be recorded; received %v", key, sol.Slacks)
+	}
+
+	if _, tf := sol.Origins[key]; !tf {
+		t.Errorf("expected the origin of %v to be recorded", key)
+	}
+}
+
+/*
+TestGurobiSolver_OptimizeExtended4
+Description:
+
+	Optimizes the infeasible model
+		constraint0: x >= [2, 0]
+		constraint1: x <= [1, 5]
+	Verifies that OptimizeExtended() reports the failed solve but still returns the
+	statistics and the origins of the rows, with no slacks or duals.
+*/
+func TestGurobiSolver_OptimizeExtended4(t *testing.T) {
+	// Constants
+	gs := mpgSolver.NewGurobiSolver("testgurobisolver-optimizeextended4")
+	defer os.Remove(gs.ModelName + ".log")
+	defer gs.Free()
+
+	model := optim.NewModel("testgurobisolver-optimizeextended4.model")
+	x := model.AddVariableVectorClassic(2, -10.0, 10.0, optim.Continuous)
+
+	err := gs.AddVariables(x.Elements)
+	if err != nil {
+		t.Errorf("unexpected error adding variables: %v", err)
+	}
+
+	err = gs.AddConstraint(x.GreaterEq(*mat.NewVecDense(2, []float64{2.0, 0.0})))
+	if err != nil {
+		t.Fatalf("unexpected error adding constraint 0: %v", err)
+	}
+
+	err = gs.AddConstraint(x.LessEq(*mat.NewVecDense(2, []float64{1.0, 5.0})))
+	if err != nil {
+		t.Fatalf("unexpected error adding constraint 1: %v", err)
+	}
+
+	// Algorithm
+	sol, err := gs.OptimizeExtended()
+
+	// Test
+	if err == nil {
+		t.Errorf("expected an error for the infeasible model, but received none!")
+	}
+
+	if sol.Status != gurobi.INFEASIBLE && sol.Status != gurobi.INF_OR_UNBD {
+		t.Errorf("expected an infeasible status; received %v", sol.Status)
+	}
+
+	if len(sol.Origins) != 4 {
+		t.Errorf("expected the origins of 4 rows; received %v", sol.Origins)
+	}
+
+	if len(sol.Slacks) != 0 || len(sol.Duals) != 0 {
+		t.Errorf("expected no slacks or duals without a solution; received %v and %v", sol.Slacks, sol.Duals)
+	}
+
+	if sol.Runtime < 0.0 {
+		t.Errorf("expected a nonnegative runtime; received %v", sol.Runtime)
+	}
+}